@@ -1,14 +1,161 @@
 package v1beta1
 
 import (
+	"strings"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // CartridgeConfigSpec defines the desired state of CartridgeConfig.
 type CartridgeConfigSpec struct {
 	// Data contains the configuration data.
-	// +kubebuilder:validation:Required
+	// +optional
 	Data string `json:"data,omitempty"`
+
+	// Sources is a list of ConfigMaps and Secrets which contain configuration sections.
+	// Sections are merged in the following order: Data first, then each source in the order they are listed,
+	// so a section defined by a later source replaces the same section defined earlier.
+	// +optional
+	Sources []CartridgeConfigSource `json:"sources,omitempty"`
+
+	// Priority defines the order in which CartridgeConfigs of the same cluster claim sections.
+	// CartridgeConfig with higher priority goes first, ties are broken by creation time and then by name.
+	// A section may be owned only by one CartridgeConfig, any other CartridgeConfig which declares
+	// the same section goes to Conflict phase and is not applied.
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Mode defines whether operator applies config (apply) or only reports a drift (observe-only)
+	// +kubebuilder:validation:Enum=apply;observe-only
+	// +kubebuilder:default=apply
+	// +optional
+	Mode CartridgeConfigMode `json:"mode,omitempty"`
+
+	// DriftCheckInterval defines how often actual clusterwide config is compared with desired one
+	// +kubebuilder:default="1m"
+	// +optional
+	DriftCheckInterval *metav1.Duration `json:"driftCheckInterval,omitempty"`
+
+	// HistoryLimit is the number of applied config revisions kept in status
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+}
+
+// CartridgeConfigMode defines how operator handles a difference between desired and actual config.
+// +enum.
+type CartridgeConfigMode string
+
+const (
+	CartridgeConfigModeApply       CartridgeConfigMode = "apply"
+	CartridgeConfigModeObserveOnly CartridgeConfigMode = "observe-only"
+)
+
+const (
+	DefaultCartridgeConfigDriftCheckInterval = time.Minute
+	DefaultCartridgeConfigHistoryLimit       = int32(10)
+)
+
+// CartridgeConfigSource represents a ConfigMap or a Secret which contains configuration sections.
+// Exactly one of ConfigMap or Secret must be specified.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type CartridgeConfigSource struct {
+	// ConfigMap selects a ConfigMap in the namespace of CartridgeConfig
+	// +optional
+	ConfigMap *CartridgeConfigObjectSource `json:"configMap,omitempty"`
+
+	// Secret selects a Secret in the namespace of CartridgeConfig
+	// +optional
+	Secret *CartridgeConfigObjectSource `json:"secret,omitempty"`
+}
+
+// GetKind returns kind of referenced object or empty string if not exactly one of ConfigMap or Secret is set.
+func (in *CartridgeConfigSource) GetKind() api.CartridgeConfigSourceKind {
+	switch {
+	case in.Secret != nil && in.ConfigMap == nil:
+		return api.CartridgeConfigSourceSecret
+	case in.ConfigMap != nil && in.Secret == nil:
+		return api.CartridgeConfigSourceConfigMap
+	}
+
+	return ""
+}
+
+func (in *CartridgeConfigSource) object() *CartridgeConfigObjectSource {
+	if in.Secret != nil {
+		return in.Secret
+	}
+
+	if in.ConfigMap != nil {
+		return in.ConfigMap
+	}
+
+	return &CartridgeConfigObjectSource{}
+}
+
+func (in *CartridgeConfigSource) GetName() string {
+	return in.object().Name
+}
+
+func (in *CartridgeConfigSource) GetItems() []api.CartridgeConfigSourceItem {
+	items := in.object().Items
+	result := make([]api.CartridgeConfigSourceItem, len(items))
+
+	for k := range items {
+		result[k] = &items[k]
+	}
+
+	return result
+}
+
+func (in *CartridgeConfigSource) IsOptional() bool {
+	return in.object().Optional
+}
+
+// CartridgeConfigObjectSource references a ConfigMap or a Secret.
+type CartridgeConfigObjectSource struct {
+	// Name of the referenced object
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Items maps keys of the referenced object to configuration sections.
+	// If empty, each key of the referenced object is used as a section with the same name.
+	// Each value must contain a valid yaml.
+	// +optional
+	Items []CartridgeConfigKeyToSection `json:"items,omitempty"`
+
+	// Optional specifies whether the referenced object must exist, defaults to false
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// CartridgeConfigKeyToSection maps a key of ConfigMap or Secret to a configuration section.
+type CartridgeConfigKeyToSection struct {
+	// Key is a key of the referenced object
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Section is a name of configuration section, defaults to Key
+	// +optional
+	Section string `json:"section,omitempty"`
+}
+
+func (in *CartridgeConfigKeyToSection) GetKey() string {
+	return in.Key
+}
+
+func (in *CartridgeConfigKeyToSection) GetSection() string {
+	if in.Section == "" {
+		return in.Key
+	}
+
+	return in.Section
 }
 
 // CartridgeConfigPhase is a label for the condition of a CartridgeConfig at the current time.
@@ -16,17 +163,65 @@ type CartridgeConfigSpec struct {
 type CartridgeConfigPhase string
 
 const (
+	CartridgeConfigLoadingSources    CartridgeConfigPhase = "LoadingSources"
 	CartridgeConfigWaitingForCluster CartridgeConfigPhase = "WaitingForCluster"
 	CartridgeConfigWaitingForLeader  CartridgeConfigPhase = "WaitingForLeader"
 	CartridgeConfigApplying          CartridgeConfigPhase = "Applying"
 	CartridgeConfigReady             CartridgeConfigPhase = "Ready"
+	CartridgeConfigConflict          CartridgeConfigPhase = "Conflict"
+	CartridgeConfigDrifted           CartridgeConfigPhase = "Drifted"
+	CartridgeConfigInvalid           CartridgeConfigPhase = "Invalid"
 )
 
+const (
+	// CartridgeConfigConditionDrifted is true when actual clusterwide config differs from previously applied one.
+	CartridgeConfigConditionDrifted = "Drifted"
+
+	CartridgeConfigReasonInSync  = "InSync"
+	CartridgeConfigReasonDrifted = "KeysChanged"
+)
+
+// CartridgeConfigError describes an error returned by application for the config.
+type CartridgeConfigError struct {
+	// ClassName is a class of the lua error
+	ClassName string `json:"className,omitempty"`
+
+	// Message is a message of the lua error
+	Message string `json:"message"`
+}
+
+// CartridgeConfigHistoryEntry describes a single applied revision of config.
+type CartridgeConfigHistoryEntry struct {
+	// Hash of the applied config
+	Hash string `json:"hash"`
+
+	// AppliedAt is the time when config was applied
+	AppliedAt metav1.Time `json:"appliedAt"`
+}
+
 // CartridgeConfigStatus defines the observed state of CartridgeConfig.
 type CartridgeConfigStatus struct {
 	// Phase indicates current state of CartridgeConfig
 	// +kubebuilder:default=Pending
 	Phase CartridgeConfigPhase `json:"phase"`
+
+	// OwnedSections is a list of top-level config sections owned by this CartridgeConfig
+	// +optional
+	OwnedSections []string `json:"ownedSections,omitempty"`
+
+	// Conditions represent the latest available observations of CartridgeConfig state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// History contains the latest applied revisions of config, the newest goes first
+	// +optional
+	History []CartridgeConfigHistoryEntry `json:"history,omitempty"`
+
+	// Error contains the last error returned by application on config validation or applying
+	// +optional
+	Error *CartridgeConfigError `json:"error,omitempty"`
 }
 
 // CartridgeConfig is the Schema for the cartridgeconfigs API
@@ -35,6 +230,9 @@ type CartridgeConfigStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
+// +kubebuilder:printcolumn:name="Priority",type="integer",JSONPath=".spec.priority",priority=1
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode",priority=1
+// +kubebuilder:printcolumn:name="Drifted",type="string",JSONPath=".status.conditions[?(@.type=='Drifted')].status",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type CartridgeConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -45,7 +243,11 @@ type CartridgeConfig struct {
 }
 
 func (in *CartridgeConfig) ResetStatus() {
-	in.Status = CartridgeConfigStatus{}
+	in.Status = CartridgeConfigStatus{
+		OwnedSections: in.Status.OwnedSections,
+		Conditions:    in.Status.Conditions,
+		History:       in.Status.History,
+	}
 }
 
 func (in *CartridgeConfig) SetPhase(phase CartridgeConfigPhase) {
@@ -60,6 +262,107 @@ func (in *CartridgeConfig) GetData() []byte {
 	return []byte(in.Spec.Data)
 }
 
+func (in *CartridgeConfig) GetSources() []api.CartridgeConfigSource {
+	result := make([]api.CartridgeConfigSource, len(in.Spec.Sources))
+
+	for k := range in.Spec.Sources {
+		result[k] = &in.Spec.Sources[k]
+	}
+
+	return result
+}
+
+func (in *CartridgeConfig) GetPriority() int32 {
+	return in.Spec.Priority
+}
+
+func (in *CartridgeConfig) SetOwnedSections(sections []string) {
+	in.Status.OwnedSections = sections
+}
+
+func (in *CartridgeConfig) GetOwnedSections() []string {
+	return in.Status.OwnedSections
+}
+
+func (in *CartridgeConfig) IsObserveOnly() bool {
+	return in.Spec.Mode == CartridgeConfigModeObserveOnly
+}
+
+func (in *CartridgeConfig) GetDriftCheckInterval() time.Duration {
+	if in.Spec.DriftCheckInterval == nil || in.Spec.DriftCheckInterval.Duration <= 0 {
+		return DefaultCartridgeConfigDriftCheckInterval
+	}
+
+	return in.Spec.DriftCheckInterval.Duration
+}
+
+func (in *CartridgeConfig) GetHistoryLimit() int32 {
+	if in.Spec.HistoryLimit == nil || *in.Spec.HistoryLimit < 1 {
+		return DefaultCartridgeConfigHistoryLimit
+	}
+
+	return *in.Spec.HistoryLimit
+}
+
+func (in *CartridgeConfig) SetDrifted(changedKeys []string) {
+	condition := metav1.Condition{
+		Type:               CartridgeConfigConditionDrifted,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             CartridgeConfigReasonInSync,
+		Message:            "Actual config matches desired one",
+	}
+
+	if len(changedKeys) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = CartridgeConfigReasonDrifted
+		condition.Message = "Changed keys: " + strings.Join(changedKeys, ", ")
+	}
+
+	meta.SetStatusCondition(&in.Status.Conditions, condition)
+}
+
+func (in *CartridgeConfig) SetError(className, message string) {
+	in.Status.Error = &CartridgeConfigError{
+		ClassName: className,
+		Message:   message,
+	}
+}
+
+func (in *CartridgeConfig) AddHistoryEntry(hash string, appliedAt time.Time) {
+	history := append([]CartridgeConfigHistoryEntry{
+		{
+			Hash:      hash,
+			AppliedAt: metav1.NewTime(appliedAt),
+		},
+	}, in.Status.History...)
+
+	if limit := int(in.GetHistoryLimit()); len(history) > limit {
+		history = history[:limit]
+	}
+
+	in.Status.History = history
+}
+
+func (in *CartridgeConfig) GetLastAppliedHash() string {
+	if len(in.Status.History) == 0 {
+		return ""
+	}
+
+	return in.Status.History[0].Hash
+}
+
+// HasSource reports whether CartridgeConfig refers to the object of given kind and name.
+func (in *CartridgeConfig) HasSource(kind api.CartridgeConfigSourceKind, name string) bool {
+	for _, source := range in.GetSources() {
+		if source.GetKind() == kind && source.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 //+kubebuilder:object:root=true
 
 // CartridgeConfigList contains a list of CartridgeConfig.
@@ -0,0 +1,122 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CartridgeSchemaSpec defines the desired state of CartridgeSchema.
+type CartridgeSchemaSpec struct {
+	// Schema contains the data model declared in the format of Cartridge DDL module.
+	// More info: https://github.com/tarantool/ddl#input-data-format
+	// +kubebuilder:validation:Required
+	Schema string `json:"schema"`
+
+	// AllowDestructive allows to apply a schema which removes spaces, fields or indexes,
+	// changes types of fields or engines of spaces declared by previously applied schema
+	// +kubebuilder:default=false
+	// +optional
+	AllowDestructive bool `json:"allowDestructive,omitempty"`
+}
+
+// CartridgeSchemaPhase is a label for the condition of a CartridgeSchema at the current time.
+// +enum.
+type CartridgeSchemaPhase string
+
+const (
+	CartridgeSchemaWaitingForCluster CartridgeSchemaPhase = "WaitingForCluster"
+	CartridgeSchemaWaitingForLeader  CartridgeSchemaPhase = "WaitingForLeader"
+	CartridgeSchemaApplying          CartridgeSchemaPhase = "Applying"
+	CartridgeSchemaReady             CartridgeSchemaPhase = "Ready"
+	CartridgeSchemaInvalid           CartridgeSchemaPhase = "Invalid"
+	CartridgeSchemaDestructive       CartridgeSchemaPhase = "DestructiveChange"
+)
+
+// CartridgeSchemaError describes an error of schema validation or applying.
+type CartridgeSchemaError struct {
+	// ClassName is a class of the error
+	ClassName string `json:"className,omitempty"`
+
+	// Message is a message of the error
+	Message string `json:"message"`
+}
+
+// CartridgeSchemaStatus defines the observed state of CartridgeSchema.
+type CartridgeSchemaStatus struct {
+	// Phase indicates current state of CartridgeSchema
+	// +kubebuilder:default=Pending
+	Phase CartridgeSchemaPhase `json:"phase"`
+
+	// AppliedVersion is a hash of the last applied schema
+	// +optional
+	AppliedVersion string `json:"appliedVersion,omitempty"`
+
+	// Error contains the last error of schema validation or applying
+	// +optional
+	Error *CartridgeSchemaError `json:"error,omitempty"`
+}
+
+// CartridgeSchema is the Schema for the cartridgeschemas API
+// More info: https://www.tarantool.io/en/doc/latest/book/cartridge/cartridge_dev/#defining-the-data-schema
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.appliedVersion",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CartridgeSchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CartridgeSchemaSpec   `json:"spec,omitempty"`
+	Status CartridgeSchemaStatus `json:"status,omitempty"`
+}
+
+func (in *CartridgeSchema) ResetStatus() {
+	in.Status = CartridgeSchemaStatus{
+		AppliedVersion: in.Status.AppliedVersion,
+	}
+}
+
+func (in *CartridgeSchema) SetPhase(phase CartridgeSchemaPhase) {
+	in.Status.Phase = phase
+}
+
+func (in *CartridgeSchema) GetPhase() CartridgeSchemaPhase {
+	return in.Status.Phase
+}
+
+func (in *CartridgeSchema) GetSchema() string {
+	return in.Spec.Schema
+}
+
+func (in *CartridgeSchema) IsDestructiveAllowed() bool {
+	return in.Spec.AllowDestructive
+}
+
+func (in *CartridgeSchema) SetAppliedVersion(version string) {
+	in.Status.AppliedVersion = version
+}
+
+func (in *CartridgeSchema) GetAppliedVersion() string {
+	return in.Status.AppliedVersion
+}
+
+func (in *CartridgeSchema) SetError(className, message string) {
+	in.Status.Error = &CartridgeSchemaError{
+		ClassName: className,
+		Message:   message,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// CartridgeSchemaList contains a list of CartridgeSchema.
+type CartridgeSchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CartridgeSchema `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CartridgeSchema{}, &CartridgeSchemaList{})
+}
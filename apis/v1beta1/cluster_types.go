@@ -1,14 +1,26 @@
 package v1beta1
 
 import (
+	"time"
+
 	"github.com/tarantool/tarantool-operator/pkg/api"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // ClusterSpec defines the desired state of Cluster
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Failover",type="string",JSONPath=".failover.mode",priority=0
 type ClusterSpec struct {
+	// Flavor selects how instances are configured: by Cartridge topology API,
+	// by declarative cluster config of Tarantool 3.x rendered into ConfigMap
+	// or by box.cfg replication of plain Tarantool set up by operator
+	// +optional
+	// +kubebuilder:validation:Enum=cartridge;tarantool3;plain
+	// +kubebuilder:default=cartridge
+	Flavor api.ClusterFlavor `json:"flavor,omitempty"`
+
 	// Domain is kubernetes cluster domain, defaults to: "cluster.local".
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=cluster.local
@@ -25,6 +37,210 @@ type ClusterSpec struct {
 
 	// Failover defines foreign cartridge instance as topology leader
 	ForeignLeader string `json:"foreignLeader,omitempty"`
+
+	// Metrics enables metrics Service per role and ServiceMonitor for instances of cluster,
+	// ServiceMonitor is created only if Prometheus Operator CRDs are installed
+	// +optional
+	Metrics *ClusterMetrics `json:"metrics,omitempty"`
+
+	// TLS enables SSL transport of Tarantool Enterprise for iproto connections between instances.
+	// It does not apply to the operator: it runs lua through pod exec and the local control socket of instance,
+	// not over iproto, and advertise URIs of instances stay host:port
+	// +optional
+	TLS *ClusterTLS `json:"tls,omitempty"`
+
+	// Tarantool3 defines credentials of cluster config, it is used only with tarantool3 flavor
+	// +optional
+	Tarantool3 *ClusterTarantool3 `json:"tarantool3,omitempty"`
+}
+
+// ClusterTarantool3 defines parts of Tarantool 3.x cluster config which are not derived from roles.
+// +k8s:openapi-gen=true
+type ClusterTarantool3 struct {
+	// Users are created on every instance, passwords are passed to pods in environment variables
+	// +optional
+	Users []ClusterUser `json:"users,omitempty"`
+
+	// PeerUser is used by instances to connect to each other for replication and sharding,
+	// it must be listed in users with replication role, and sharding role if cluster has vshard storages
+	// +optional
+	PeerUser string `json:"peerUser,omitempty"`
+
+	// Config is YAML of cluster config merged with sections generated by operator, e.g. snapshot, wal or app options,
+	// options generated by operator take precedence
+	// +optional
+	Config string `json:"config,omitempty"`
+}
+
+// ClusterUser is a user of Tarantool 3.x cluster config.
+// +k8s:openapi-gen=true
+type ClusterUser struct {
+	// Name of user
+	Name string `json:"name"`
+
+	// Roles granted to user, e.g. replication, sharding, super
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// PasswordSecretKeyRef selects password of user in Secret of cluster namespace
+	// +optional
+	PasswordSecretKeyRef *corev1.SecretKeySelector `json:"passwordSecretKeyRef,omitempty"`
+}
+
+func (in *ClusterTarantool3) GetUsers() []api.ClusterUser {
+	users := make([]api.ClusterUser, len(in.Users))
+	for k := range in.Users {
+		users[k] = &in.Users[k]
+	}
+
+	return users
+}
+
+func (in *ClusterTarantool3) GetPeerUser() string {
+	return in.PeerUser
+}
+
+func (in *ClusterTarantool3) GetConfig() string {
+	return in.Config
+}
+
+func (in *ClusterUser) GetName() string {
+	return in.Name
+}
+
+func (in *ClusterUser) GetRoles() []string {
+	return in.Roles
+}
+
+func (in *ClusterUser) GetPasswordSecretKeyRef() *corev1.SecretKeySelector {
+	return in.PasswordSecretKeyRef
+}
+
+// ClusterTLS defines certificates of SSL transport, they are mounted into every instance of cluster.
+// Connections of the operator to instances do not use them, see TLS field of ClusterSpec.
+// +k8s:openapi-gen=true
+type ClusterTLS struct {
+	// SecretName is a name of Secret with tls.crt, tls.key and ca.crt keys,
+	// the same certificate is used by instances as server and as client
+	SecretName string `json:"secretName"`
+
+	// IssuerRef makes operator request the certificate from cert-manager and store it in SecretName,
+	// the certificate is valid for FQDNs of all instances of cluster
+	// +optional
+	IssuerRef *ClusterTLSIssuerRef `json:"issuerRef,omitempty"`
+
+	// MountPath is a directory where certificates are mounted, defaults to /etc/tarantool/tls
+	// +optional
+	// +kubebuilder:default=/etc/tarantool/tls
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Ciphers is a colon-separated list of SSL ciphers, OpenSSL defaults are used if it is empty
+	// +optional
+	Ciphers string `json:"ciphers,omitempty"`
+}
+
+// ClusterTLSIssuerRef refers to cert-manager Issuer or ClusterIssuer.
+// +k8s:openapi-gen=true
+type ClusterTLSIssuerRef struct {
+	// Name of issuer
+	Name string `json:"name"`
+
+	// Kind of issuer, Issuer or ClusterIssuer, defaults to Issuer
+	// +optional
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=Issuer
+	Kind string `json:"kind,omitempty"`
+
+	// Group of issuer, defaults to cert-manager.io
+	// +optional
+	// +kubebuilder:default=cert-manager.io
+	Group string `json:"group,omitempty"`
+}
+
+func (in *ClusterTLS) GetSecretName() string {
+	return in.SecretName
+}
+
+func (in *ClusterTLS) GetMountPath() string {
+	if in.MountPath == "" {
+		return "/etc/tarantool/tls"
+	}
+
+	return in.MountPath
+}
+
+func (in *ClusterTLS) GetCiphers() string {
+	return in.Ciphers
+}
+
+func (in *ClusterTLS) GetIssuerName() string {
+	if in.IssuerRef == nil {
+		return ""
+	}
+
+	return in.IssuerRef.Name
+}
+
+func (in *ClusterTLS) GetIssuerKind() string {
+	if in.IssuerRef == nil || in.IssuerRef.Kind == "" {
+		return "Issuer"
+	}
+
+	return in.IssuerRef.Kind
+}
+
+func (in *ClusterTLS) GetIssuerGroup() string {
+	if in.IssuerRef == nil || in.IssuerRef.Group == "" {
+		return "cert-manager.io"
+	}
+
+	return in.IssuerRef.Group
+}
+
+// ClusterMetrics defines where Cartridge exposes metrics of instances.
+// +k8s:openapi-gen=true
+type ClusterMetrics struct {
+	// Port of Cartridge HTTP server where metrics endpoint is served, defaults to 8081
+	// +optional
+	// +kubebuilder:default=8081
+	Port int32 `json:"port,omitempty"`
+
+	// Path of metrics endpoint, defaults to /metrics
+	// +optional
+	// +kubebuilder:default=/metrics
+	Path string `json:"path,omitempty"`
+
+	// Interval of scraping, Prometheus global interval is used if it is empty
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// Labels are added to ServiceMonitor to match serviceMonitorSelector of Prometheus
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func (in *ClusterMetrics) GetPort() int32 {
+	if in.Port == 0 {
+		return 8081
+	}
+
+	return in.Port
+}
+
+func (in *ClusterMetrics) GetPath() string {
+	if in.Path == "" {
+		return "/metrics"
+	}
+
+	return in.Path
+}
+
+func (in *ClusterMetrics) GetInterval() string {
+	return in.Interval
+}
+
+func (in *ClusterMetrics) GetLabels() map[string]string {
+	return in.Labels
 }
 
 // FailoverConfig defines cartridge failover params
@@ -70,6 +286,10 @@ type FailoverConfig struct {
 	// Stateboard is a config for "stateboard" failover state provider
 	// +optional
 	Stateboard *FailoverStateboard `json:"stateboard"`
+
+	// Raft tunes leader election of replicasets in "raft" mode, it is applied with box.cfg on every instance
+	// +optional
+	Raft *FailoverRaft `json:"raft,omitempty"`
 }
 
 func (in *FailoverConfig) GetMode() api.FailoverMode {
@@ -104,6 +324,42 @@ func (in *FailoverConfig) GetStateboardConfig() api.FailoverStateboardConfig {
 	return in.Stateboard
 }
 
+func (in *FailoverConfig) GetRaftConfig() api.FailoverRaftConfig {
+	if in.Raft == nil {
+		return nil
+	}
+
+	return in.Raft
+}
+
+// FailoverRaft is a config of Raft based leader election used by "raft" failover mode
+// More info: https://www.tarantool.io/en/doc/latest/concepts/replication/repl_leader_elect/
+// +k8s:openapi-gen=true
+type FailoverRaft struct {
+	// ElectionTimeout is a time after which candidate starts new election if no leader is elected,
+	// Tarantool default (5s) is used if unset
+	// +optional
+	ElectionTimeout *metav1.Duration `json:"electionTimeout,omitempty"`
+
+	// SynchroQuorum is a number of instances which must confirm synchronous transactions and votes of election,
+	// it is either a number or a formula with N standing for number of instances like "N / 2 + 1" (Tarantool default)
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	SynchroQuorum *intstr.IntOrString `json:"synchroQuorum,omitempty"`
+}
+
+func (in *FailoverRaft) GetElectionTimeout() time.Duration {
+	if in.ElectionTimeout == nil {
+		return 0
+	}
+
+	return in.ElectionTimeout.Duration
+}
+
+func (in *FailoverRaft) GetSynchroQuorum() *intstr.IntOrString {
+	return in.SynchroQuorum
+}
+
 // FailoverEtcd2 is a config for "etcd2" failover state provider
 // +k8s:openapi-gen=true
 type FailoverEtcd2 struct {
@@ -210,6 +466,7 @@ const (
 	ClusterReady               ClusterPhase = "Ready"
 	ClusterUnableToBootstrap   ClusterPhase = "UnableToBootstrap"
 	ClusterFailoverConfiguring ClusterPhase = "FailoverConfiguring"
+	ClusterConfiguring         ClusterPhase = "Configuring"
 )
 
 // ClusterStatus defines the observed state of Cluster
@@ -226,6 +483,10 @@ type ClusterStatus struct {
 	// Leader indicates name of pod which use to control topology
 	// +optional
 	Leader string `json:"leader"`
+
+	// AppliedConfigHash is a hash of Tarantool 3.x cluster config reloaded by all running instances
+	// +optional
+	AppliedConfigHash string `json:"appliedConfigHash,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -258,6 +519,38 @@ func (in *Cluster) GetFailoverConfig() api.FailoverConfig {
 	return &in.Spec.Failover
 }
 
+func (in *Cluster) GetMetricsConfig() api.MetricsConfig {
+	if in.Spec.Metrics == nil {
+		return nil
+	}
+
+	return in.Spec.Metrics
+}
+
+func (in *Cluster) GetFlavor() api.ClusterFlavor {
+	if in.Spec.Flavor == "" {
+		return api.ClusterFlavorCartridge
+	}
+
+	return in.Spec.Flavor
+}
+
+func (in *Cluster) GetTarantool3Config() api.Tarantool3Config {
+	if in.Spec.Tarantool3 == nil {
+		return &ClusterTarantool3{}
+	}
+
+	return in.Spec.Tarantool3
+}
+
+func (in *Cluster) GetTLSConfig() api.TLSConfig {
+	if in.Spec.TLS == nil {
+		return nil
+	}
+
+	return in.Spec.TLS
+}
+
 func (in *Cluster) SetLeader(leader string) {
 	in.Status.Leader = leader
 }
@@ -283,9 +576,19 @@ func (in *Cluster) ResetStatus() {
 		Phase:        "",
 		Bootstrapped: in.Status.Bootstrapped,
 		Leader:       in.Status.Leader,
+		// Config is reloaded only when its hash differs from the applied one
+		AppliedConfigHash: in.Status.AppliedConfigHash,
 	}
 }
 
+func (in *Cluster) GetAppliedConfigHash() string {
+	return in.Status.AppliedConfigHash
+}
+
+func (in *Cluster) SetAppliedConfigHash(hash string) {
+	in.Status.AppliedConfigHash = hash
+}
+
 func (in *Cluster) SetPhase(phase ClusterPhase) {
 	in.Status.Phase = phase
 }
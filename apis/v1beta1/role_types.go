@@ -2,11 +2,17 @@ package v1beta1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // RoleSpec defines the desired state of Role.
@@ -26,10 +32,54 @@ type RoleSpec struct {
 	// +optional
 	AllRw bool `json:"allRw"`
 
+	// MaxUnavailable is a number or percentage of pods of each replicaset which can be unavailable
+	// during voluntary disruptions like node drain, it is set in PodDisruptionBudget of replicaset, defaults to 1
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Placement spreads pods of each replicaset across nodes or zones,
+	// it is merged with affinity and topology spread constraints of pod template
+	// +optional
+	Placement *RolePlacement `json:"placement,omitempty"`
+
+	// PrimaryZone is a zone where masters of replicasets are preferred to be,
+	// instances in this zone go first in failover priority of each replicaset
+	// +optional
+	PrimaryZone string `json:"primaryZone,omitempty"`
+
+	// AllowAutoscaling allows scale subresource to be used by autoscalers for role with vshard-storage cluster role,
+	// roles without vshard-storage are stateless and can always be autoscaled.
+	// Storage replicasets beyond spec.replicasets are not removed until it is set.
+	// +optional
+	AllowAutoscaling bool `json:"allowAutoscaling,omitempty"`
+
+	// Service enables client Services of role, storage roles also get -rw Service selecting masters
+	// of replicasets and -ro Service selecting replicas
+	// +optional
+	Service *RoleService `json:"service,omitempty"`
+
+	// StoragePolicy enables periodic checks of memtx and vinyl usage of instances,
+	// usage above thresholds is reported or handled by adding replicasets
+	// +optional
+	StoragePolicy *RoleStoragePolicy `json:"storagePolicy,omitempty"`
+
 	// VShard defines config for vshard
 	// See more: https://www.tarantool.io/doc/latest/reference/reference_rock/vshard/
 	// +kubebuilder:validation:Required
 	VShard RoleVShardConfig `json:"vshard"`
+
+	// Replication tunes box.cfg replication options of instances, they are applied after instances join cluster
+	// and synchro quorum is recalculated when number of replicas is changed
+	// +optional
+	Replication *RoleReplication `json:"replication,omitempty"`
+
+	// ElectionMode is a box.cfg election_mode of instances of role used by "raft" failover mode,
+	// every replicaset must have candidates to elect a leader, defaults to candidate
+	// +optional
+	// +kubebuilder:validation:Enum=candidate;voter;off
+	ElectionMode api.ElectionMode `json:"electionMode,omitempty"`
 }
 
 // ReplicasetTemplate is StatefulSet.Spec but with some fields omit
@@ -72,6 +122,229 @@ type ReplicasetTemplate struct {
 	UpdateStrategy appsv1.StatefulSetUpdateStrategy `json:"updateStrategy"`
 }
 
+// RoleReplication defines box.cfg replication options of instances of role, Tarantool defaults are used for unset fields
+// More info: https://www.tarantool.io/en/doc/latest/reference/configuration/#replication
+// +k8s:openapi-gen=true
+type RoleReplication struct {
+	// SynchroQuorum is a number of instances which must confirm synchronous transactions,
+	// it is either a number or a formula with N standing for number of replicas of replicaset like "N / 2 + 1",
+	// formula is evaluated by operator and result is bounded by number of replicas
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	SynchroQuorum *intstr.IntOrString `json:"synchroQuorum,omitempty"`
+
+	// SynchroTimeout is a time synchronous transaction waits for quorum before it is rolled back
+	// +optional
+	SynchroTimeout *metav1.Duration `json:"synchroTimeout,omitempty"`
+
+	// Timeout is a replication_timeout, heartbeat interval of replicas, peer is considered lost after 4 timeouts
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ConnectQuorum is a number of peers instance must connect to on start to become writable
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ConnectQuorum *int32 `json:"connectQuorum,omitempty"`
+}
+
+func (in *RoleReplication) GetSynchroQuorum() *intstr.IntOrString {
+	return in.SynchroQuorum
+}
+
+func (in *RoleReplication) GetSynchroTimeout() time.Duration {
+	if in.SynchroTimeout == nil {
+		return 0
+	}
+
+	return in.SynchroTimeout.Duration
+}
+
+func (in *RoleReplication) GetTimeout() time.Duration {
+	if in.Timeout == nil {
+		return 0
+	}
+
+	return in.Timeout.Duration
+}
+
+func (in *RoleReplication) GetConnectQuorum() *int32 {
+	return in.ConnectQuorum
+}
+
+// PlacementTopology is a topology domain pods of replicaset are spread across.
+// +enum.
+type PlacementTopology string
+
+const (
+	PlacementTopologyNode PlacementTopology = "node"
+	PlacementTopologyZone PlacementTopology = "zone"
+)
+
+// PlacementMode defines whether placement is enforced by scheduler or is only preferred.
+// +enum.
+type PlacementMode string
+
+const (
+	PlacementRequired  PlacementMode = "required"
+	PlacementPreferred PlacementMode = "preferred"
+)
+
+// RolePlacement defines how pods of the same replicaset are placed relative to each other.
+// +k8s:openapi-gen=true
+type RolePlacement struct {
+	// SpreadReplicasAcross is a topology domain pods of the same replicaset are spread across
+	// +kubebuilder:validation:Enum=node;zone
+	// +kubebuilder:validation:Required
+	SpreadReplicasAcross PlacementTopology `json:"spreadReplicasAcross"`
+
+	// Mode is required to never schedule pods of replicaset into the same domain,
+	// or preferred to allow it when there are not enough domains, defaults to preferred
+	// +kubebuilder:validation:Enum=required;preferred
+	// +kubebuilder:default=preferred
+	// +optional
+	Mode PlacementMode `json:"mode,omitempty"`
+}
+
+func (in *RolePlacement) GetTopologyKey() string {
+	if in.SpreadReplicasAcross == PlacementTopologyZone {
+		return v1.LabelTopologyZone
+	}
+
+	return v1.LabelHostname
+}
+
+func (in *RolePlacement) IsRequired() bool {
+	return in.Mode == PlacementRequired
+}
+
+// RoleService defines client Services of role.
+// +k8s:openapi-gen=true
+type RoleService struct {
+	// Type of client Services, defaults to ClusterIP
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	Type v1.ServiceType `json:"type,omitempty"`
+
+	// Annotations are added to client Services, e.g. to configure cloud load balancers,
+	// annotations removed from here are removed from Services
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func (in *RoleService) GetType() v1.ServiceType {
+	if in.Type == "" {
+		return v1.ServiceTypeClusterIP
+	}
+
+	return in.Type
+}
+
+// StoragePolicyAction defines what is done when storage usage exceeds thresholds.
+// +enum.
+type StoragePolicyAction string
+
+const (
+	StoragePolicyWarn     StoragePolicyAction = "Warn"
+	StoragePolicyScaleOut StoragePolicyAction = "ScaleOut"
+)
+
+// RoleStoragePolicy defines thresholds of memtx and vinyl usage of instances of role.
+// +k8s:openapi-gen=true
+type RoleStoragePolicy struct {
+	// MemtxThreshold is a percentage of memtx_memory used by tuples and indexes, defaults to 80
+	// +optional
+	// +kubebuilder:default=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MemtxThreshold int32 `json:"memtxThreshold,omitempty"`
+
+	// VinylThreshold is a percentage of VinylDiskLimit used by vinyl data and indexes, defaults to 80
+	// +optional
+	// +kubebuilder:default=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	VinylThreshold int32 `json:"vinylThreshold,omitempty"`
+
+	// VinylDiskLimit is a disk space available to vinyl on each instance, vinyl usage is not checked if it is not set
+	// +optional
+	VinylDiskLimit *resource.Quantity `json:"vinylDiskLimit,omitempty"`
+
+	// Action is Warn to report usage in events and StorageUsageHigh condition only,
+	// or ScaleOut to add a replicaset as well, defaults to Warn
+	// +optional
+	// +kubebuilder:validation:Enum=Warn;ScaleOut
+	// +kubebuilder:default=Warn
+	Action StoragePolicyAction `json:"action,omitempty"`
+
+	// MaxReplicasets is a number of replicasets ScaleOut action is allowed to grow role up to,
+	// it is required by ScaleOut action and must be greater than spec.replicasets
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicasets int32 `json:"maxReplicasets,omitempty"`
+
+	// Interval between usage checks, defaults to 1m
+	// +optional
+	// +kubebuilder:default="1m"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Cooldown is a minimal time between scale outs which gives vshard time to rebalance buckets, defaults to 30m
+	// +optional
+	// +kubebuilder:default="30m"
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+func (in *RoleStoragePolicy) GetMemtxThreshold() int32 {
+	if in.MemtxThreshold == 0 {
+		return 80
+	}
+
+	return in.MemtxThreshold
+}
+
+func (in *RoleStoragePolicy) GetVinylThreshold() int32 {
+	if in.VinylThreshold == 0 {
+		return 80
+	}
+
+	return in.VinylThreshold
+}
+
+func (in *RoleStoragePolicy) GetVinylDiskLimit() int64 {
+	if in.VinylDiskLimit == nil {
+		return 0
+	}
+
+	return in.VinylDiskLimit.Value()
+}
+
+func (in *RoleStoragePolicy) IsScaleOut() bool {
+	return in.Action == StoragePolicyScaleOut
+}
+
+func (in *RoleStoragePolicy) GetMaxReplicasets() int32 {
+	return in.MaxReplicasets
+}
+
+func (in *RoleStoragePolicy) GetInterval() time.Duration {
+	if in.Interval == nil || in.Interval.Duration <= 0 {
+		return time.Minute
+	}
+
+	return in.Interval.Duration
+}
+
+func (in *RoleStoragePolicy) GetCooldown() time.Duration {
+	if in.Cooldown == nil {
+		return 30 * time.Minute
+	}
+
+	return in.Cooldown.Duration
+}
+
+// VShardStorageRole is a cluster role of replicasets storing vshard buckets.
+const VShardStorageRole = "vshard-storage"
+
 // RoleVShardConfig defines config for vshard
 // See more: https://www.tarantool.io/doc/latest/reference/reference_rock/vshard/
 // +k8s:openapi-gen=true
@@ -111,16 +384,22 @@ type RolePhase string
 
 // These are the valid statuses of Role.
 const (
-	RolePending               RolePhase = "Pending"
-	RoleWaitingForCluster     RolePhase = "WaitingForCluster"
-	RoleWaitingForLeader      RolePhase = "WaitingForLeader"
-	RoleWaitForCartridgeReady RolePhase = "WaitForCartridgeReady"
-	RoleJoining               RolePhase = "Joining"
-	RoleConfiguring           RolePhase = "Configuring"
-	RoleWaitingForBootstrap   RolePhase = "WaitingForBootstrap"
-	RoleConfiguringWeights    RolePhase = "ConfiguringWeights"
-	RoleReady                 RolePhase = "Ready"
-	RoleConfigError           RolePhase = "ConfigError"
+	RolePending                RolePhase = "Pending"
+	RoleWaitingForCluster      RolePhase = "WaitingForCluster"
+	RoleWaitingForRestore      RolePhase = "WaitingForRestore"
+	RoleExpandingVolumes       RolePhase = "ExpandingVolumes"
+	RoleWaitingForLeader       RolePhase = "WaitingForLeader"
+	RoleWaitForCartridgeReady  RolePhase = "WaitForCartridgeReady"
+	RoleJoining                RolePhase = "Joining"
+	RoleConfiguring            RolePhase = "Configuring"
+	RoleWaitingForBootstrap    RolePhase = "WaitingForBootstrap"
+	RoleConfiguringWeights     RolePhase = "ConfiguringWeights"
+	RoleConfiguringFailover    RolePhase = "ConfiguringFailoverPriority"
+	RoleConfiguringReplication RolePhase = "ConfiguringReplication"
+	RoleConfiguringElection    RolePhase = "ConfiguringElection"
+	RoleScalingDown            RolePhase = "ScalingDown"
+	RoleReady                  RolePhase = "Ready"
+	RoleConfigError            RolePhase = "ConfigError"
 )
 
 // RoleStatus defines the observed state of Role
@@ -132,15 +411,134 @@ type RoleStatus struct {
 
 	// ReadyPods a string in format "ready_pods_count/total_pods_count" for printable column
 	ReadyPods string `json:"readyPods"`
+
+	// Instances contains placement of pods of role
+	// +optional
+	Instances []RoleInstanceStatus `json:"instances,omitempty"`
+
+	// Conditions represent the latest available observations of Role state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Volumes contains PersistentVolumeClaims of role which are not yet resized to the size of volume claim template
+	// +optional
+	Volumes []RoleVolumeStatus `json:"volumes,omitempty"`
+
+	// Replicasets is an actual number of StatefulSets of role, it is a status replicas of scale subresource
+	// +optional
+	Replicasets int32 `json:"replicasets"`
+
+	// Selector is a label selector of pods of role used by autoscalers,
+	// it is empty when role can not be autoscaled
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// LastScaleOutTime is a time when storage policy added a replicaset last time
+	// +optional
+	LastScaleOutTime *metav1.Time `json:"lastScaleOutTime,omitempty"`
+
+	// LastScaleOutReplicasets is a number of replicasets set in spec by storage policy last time
+	// +optional
+	LastScaleOutReplicasets int32 `json:"lastScaleOutReplicasets,omitempty"`
+
+	// Elections contains Raft state of replicasets when cluster uses "raft" failover mode
+	// +optional
+	Elections []RoleElectionStatus `json:"elections,omitempty"`
+}
+
+const (
+	// RoleConditionSingleZone is true when all replicas of any replicaset of role are placed in the same zone.
+	RoleConditionSingleZone = "SingleZone"
+
+	// RoleConditionStorageUsageHigh is true when memtx or vinyl usage of any instance exceeds storage policy thresholds.
+	RoleConditionStorageUsageHigh = "StorageUsageHigh"
+
+	// RoleConditionScaleDownBlocked is true when storage replicasets beyond spec.replicasets are kept,
+	// because autoscaling of role is not allowed.
+	RoleConditionScaleDownBlocked = "ScaleDownBlocked"
+
+	// RoleConditionScaleOutBlocked is true when ScaleOut action of storage policy can not add a replicaset.
+	RoleConditionScaleOutBlocked = "ScaleOutBlocked"
+
+	// RoleConditionVolumesNotExpandable is true when StorageClass of any volume claim of role does not allow expansion.
+	RoleConditionVolumesNotExpandable = "VolumesNotExpandable"
+
+	RoleReasonSpread            = "Spread"
+	RoleReasonSingleZone        = "ReplicasInSameZone"
+	RoleReasonUsageNormal       = "UsageNormal"
+	RoleReasonThresholdExceeded = "ThresholdExceeded"
+	RoleReasonNotAllowed        = "AutoscalingNotAllowed"
+	RoleReasonExpansionDisabled = "ExpansionNotAllowed"
+)
+
+// RoleInstanceStatus describes where pod of role is running.
+type RoleInstanceStatus struct {
+	// Pod is a name of instance pod
+	Pod string `json:"pod"`
+
+	// Replicaset is a name of StatefulSet of instance
+	Replicaset string `json:"replicaset"`
+
+	// Node is a name of node pod is scheduled to
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// Zone is a value of topology.kubernetes.io/zone label of node
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// RoleElectionStatus describes Raft state of replicaset observed on its running instances.
+type RoleElectionStatus struct {
+	// Replicaset is a name of StatefulSet
+	Replicaset string `json:"replicaset"`
+
+	// Term is the highest Raft term known by instances of replicaset
+	Term int64 `json:"term"`
+
+	// Leader is a pod which is elected as leader in current term, empty while election is in progress
+	// +optional
+	Leader string `json:"leader,omitempty"`
+}
+
+// RoleVolumeState is a label for the condition of volume resize.
+// +enum.
+type RoleVolumeState string
+
+const (
+	RoleVolumeResizing                RoleVolumeState = "Resizing"
+	RoleVolumeFileSystemResizePending RoleVolumeState = "FileSystemResizePending"
+	RoleVolumeNotExpandable           RoleVolumeState = "NotExpandable"
+)
+
+// RoleVolumeStatus describes resize progress of a PersistentVolumeClaim.
+type RoleVolumeStatus struct {
+	// Name is a name of PersistentVolumeClaim
+	Name string `json:"name"`
+
+	// Requested is a size of volume claim template
+	Requested resource.Quantity `json:"requested"`
+
+	// Capacity is an actual size of volume
+	// +optional
+	Capacity resource.Quantity `json:"capacity,omitempty"`
+
+	// State of resize
+	State RoleVolumeState `json:"state"`
 }
 
 // Role is the Schema for the roles API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicasets,statuspath=.status.replicasets,selectorpath=.status.selector
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
 // +kubebuilder:printcolumn:name="Pods",type="number",JSONPath=".status.readyPods",priority=1
 // +kubebuilder:printcolumn:name="Weight",type="number",JSONPath=".status.weight",priority=0
+// +kubebuilder:printcolumn:name="StorageUsageHigh",type="string",JSONPath=".status.conditions[?(@.type=='StorageUsageHigh')].status",priority=1
+// +kubebuilder:printcolumn:name="SingleZone",type="string",JSONPath=".status.conditions[?(@.type=='SingleZone')].status",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -164,22 +562,281 @@ func (in *Role) GetReplicasets() int32 {
 	return *in.Spec.Replicasets
 }
 
+// IsAutoscalingAllowed returns true if role is stateless or autoscaling of storage is explicitly allowed.
+func (in *Role) IsAutoscalingAllowed() bool {
+	return in.Spec.AllowAutoscaling || !in.IsStorage()
+}
+
+func (in *Role) IsStorage() bool {
+	return utils.SliceContains(in.Spec.VShard.ClusterRoles, VShardStorageRole)
+}
+
+func (in *Role) SetScaleStatus(replicasets int32, selector string) {
+	in.Status.Replicasets = replicasets
+	in.Status.Selector = ""
+
+	if in.IsAutoscalingAllowed() {
+		in.Status.Selector = selector
+	}
+}
+
 func (in *Role) GetReplicas() int32 {
 	return *in.Spec.ReplicasetTemplate.Replicas
 }
 
+func (in *Role) GetMaxUnavailable() intstr.IntOrString {
+	if in.Spec.MaxUnavailable == nil {
+		return intstr.FromInt(1)
+	}
+
+	return *in.Spec.MaxUnavailable
+}
+
 func (in *Role) GetVolumeClaimTemplates() []v1.PersistentVolumeClaim {
 	return in.Spec.ReplicasetTemplate.VolumeClaimTemplates
 }
 
 func (in *Role) ResetStatus() {
-	in.Status = RoleStatus{}
+	// Scale is kept until it is reported again, so autoscalers never observe zero replicasets
+	// when reconciliation stops early
+	in.Status = RoleStatus{
+		Conditions:              in.Status.Conditions,
+		LastScaleOutTime:        in.Status.LastScaleOutTime,
+		LastScaleOutReplicasets: in.Status.LastScaleOutReplicasets,
+		Replicasets:             in.Status.Replicasets,
+		Selector:                in.Status.Selector,
+	}
+}
+
+func (in *Role) SetReplicasets(replicasets int32) {
+	in.Spec.Replicasets = &replicasets
+}
+
+func (in *Role) GetStoragePolicy() api.StoragePolicy {
+	if in.Spec.StoragePolicy == nil {
+		return nil
+	}
+
+	return in.Spec.StoragePolicy
+}
+
+func (in *Role) GetLastScaleOutTime() time.Time {
+	if in.Status.LastScaleOutTime == nil {
+		return time.Time{}
+	}
+
+	return in.Status.LastScaleOutTime.Time
+}
+
+func (in *Role) SetLastScaleOut(t time.Time, replicasets int32) {
+	in.Status.LastScaleOutTime = &metav1.Time{Time: t}
+	in.Status.LastScaleOutReplicasets = replicasets
+}
+
+func (in *Role) SetStorageUsageHigh(instances []string) {
+	condition := metav1.Condition{
+		Type:               RoleConditionStorageUsageHigh,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             RoleReasonUsageNormal,
+		Message:            "Storage usage of every instance is below thresholds",
+	}
+
+	if len(instances) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = RoleReasonThresholdExceeded
+		condition.Message = "Storage usage exceeds thresholds on: " + strings.Join(instances, ", ")
+	}
+
+	meta.SetStatusCondition(&in.Status.Conditions, condition)
+}
+
+// SetScaleDownBlocked records replicasets which are not removed because autoscaling of role is not allowed,
+// it returns true if the set of replicasets is changed.
+func (in *Role) SetScaleDownBlocked(replicasets []string) bool {
+	previous := meta.FindStatusCondition(in.Status.Conditions, RoleConditionScaleDownBlocked)
+
+	if len(replicasets) == 0 {
+		meta.RemoveStatusCondition(&in.Status.Conditions, RoleConditionScaleDownBlocked)
+
+		return previous != nil
+	}
+
+	condition := metav1.Condition{
+		Type:               RoleConditionScaleDownBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             RoleReasonNotAllowed,
+		Message:            "Replicasets are not removed until spec.allowAutoscaling is set: " + strings.Join(replicasets, ", "),
+	}
+
+	// Previous condition is updated in place, so it is compared before update
+	changed := previous == nil || previous.Message != condition.Message
+
+	meta.SetStatusCondition(&in.Status.Conditions, condition)
+
+	return changed
+}
+
+// SetVolumesNotExpandable records volume claims which can not be expanded, it returns true if the set of claims is changed.
+func (in *Role) SetVolumesNotExpandable(claims []string) bool {
+	previous := meta.FindStatusCondition(in.Status.Conditions, RoleConditionVolumesNotExpandable)
+
+	if len(claims) == 0 {
+		meta.RemoveStatusCondition(&in.Status.Conditions, RoleConditionVolumesNotExpandable)
+
+		return previous != nil
+	}
+
+	condition := metav1.Condition{
+		Type:               RoleConditionVolumesNotExpandable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             RoleReasonExpansionDisabled,
+		Message:            "StorageClass does not allow expansion of volume claims: " + strings.Join(claims, ", "),
+	}
+
+	// Previous condition is updated in place, so it is compared before update
+	changed := previous == nil || previous.Message != condition.Message
+
+	meta.SetStatusCondition(&in.Status.Conditions, condition)
+
+	return changed
+}
+
+func (in *Role) ClearStorageUsage() {
+	meta.RemoveStatusCondition(&in.Status.Conditions, RoleConditionStorageUsageHigh)
+	meta.RemoveStatusCondition(&in.Status.Conditions, RoleConditionScaleOutBlocked)
+}
+
+// SetScaleOutBlocked records why ScaleOut action can not add a replicaset, condition is removed when reason is empty.
+// It returns true if reason or message is changed.
+func (in *Role) SetScaleOutBlocked(reason, message string) bool {
+	previous := meta.FindStatusCondition(in.Status.Conditions, RoleConditionScaleOutBlocked)
+
+	if reason == "" {
+		meta.RemoveStatusCondition(&in.Status.Conditions, RoleConditionScaleOutBlocked)
+
+		return previous != nil
+	}
+
+	// Previous condition is updated in place, so it is compared before update
+	changed := previous == nil || previous.Reason != reason || previous.Message != message
+
+	meta.SetStatusCondition(&in.Status.Conditions, metav1.Condition{
+		Type:               RoleConditionScaleOutBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+
+	return changed
+}
+
+func (in *Role) GetPrimaryZone() string {
+	return in.Spec.PrimaryZone
+}
+
+func (in *Role) GetReplicationConfig() api.ReplicationConfig {
+	if in.Spec.Replication == nil {
+		return nil
+	}
+
+	return in.Spec.Replication
+}
+
+func (in *Role) GetElectionMode() api.ElectionMode {
+	if in.Spec.ElectionMode == "" {
+		return api.ElectionModeCandidate
+	}
+
+	return in.Spec.ElectionMode
+}
+
+func (in *Role) SetReplicasetElection(replicaset string, term int64, leader string) {
+	election := RoleElectionStatus{
+		Replicaset: replicaset,
+		Term:       term,
+		Leader:     leader,
+	}
+
+	for k := range in.Status.Elections {
+		if in.Status.Elections[k].Replicaset == replicaset {
+			in.Status.Elections[k] = election
+
+			return
+		}
+	}
+
+	in.Status.Elections = append(in.Status.Elections, election)
+}
+
+func (in *Role) SetInstanceStatus(instance RoleInstanceStatus) {
+	for k := range in.Status.Instances {
+		if in.Status.Instances[k].Pod == instance.Pod {
+			in.Status.Instances[k] = instance
+
+			return
+		}
+	}
+
+	in.Status.Instances = append(in.Status.Instances, instance)
+}
+
+func (in *Role) GetInstanceZone(pod string) string {
+	for _, instance := range in.Status.Instances {
+		if instance.Pod == pod {
+			return instance.Zone
+		}
+	}
+
+	return ""
+}
+
+// SetSingleZoneReplicasets records replicasets which replicas are placed in the same zone,
+// it returns true if status or the set of replicasets is changed.
+func (in *Role) SetSingleZoneReplicasets(replicasets []string) bool {
+	previous := meta.FindStatusCondition(in.Status.Conditions, RoleConditionSingleZone)
+
+	condition := metav1.Condition{
+		Type:               RoleConditionSingleZone,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: in.GetGeneration(),
+		Reason:             RoleReasonSpread,
+		Message:            "Replicas of every replicaset are placed in different zones or zones are unknown",
+	}
+
+	if len(replicasets) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = RoleReasonSingleZone
+		condition.Message = "All replicas are in the same zone: " + strings.Join(replicasets, ", ")
+	}
+
+	// Previous condition is updated in place, so it is compared before update
+	changed := previous == nil || previous.Status != condition.Status || previous.Message != condition.Message
+
+	meta.SetStatusCondition(&in.Status.Conditions, condition)
+
+	return changed
 }
 
 func (in *Role) SetReadyPodsCount(count int32) {
 	in.Status.ReadyPods = fmt.Sprintf("%d/%d", count, in.GetReplicasets()*in.GetReplicas())
 }
 
+func (in *Role) SetVolumeStatus(volume RoleVolumeStatus) {
+	for k := range in.Status.Volumes {
+		if in.Status.Volumes[k].Name == volume.Name {
+			in.Status.Volumes[k] = volume
+
+			return
+		}
+	}
+
+	in.Status.Volumes = append(in.Status.Volumes, volume)
+}
+
 func (in *Role) SetPhase(phase RolePhase) {
 	in.Status.Phase = phase
 }
@@ -0,0 +1,291 @@
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupStorage defines where backup files are stored. Exactly one of PVC, S3 or VolumeSnapshot must be specified.
+type BackupStorage struct {
+	// PVC stores backup files on a PersistentVolumeClaim,
+	// it must be ReadWriteMany if instances are scheduled on different nodes
+	// +optional
+	PVC *BackupPVCStorage `json:"pvc,omitempty"`
+
+	// S3 stores backup files in S3 compatible object storage, like MinIO
+	// +optional
+	S3 *BackupS3Storage `json:"s3,omitempty"`
+
+	// VolumeSnapshot takes CSI VolumeSnapshot of data volume of each instance instead of copying files
+	// +optional
+	VolumeSnapshot *BackupVolumeSnapshotStorage `json:"volumeSnapshot,omitempty"`
+
+	// Image is used by jobs which copy backup files,
+	// defaults to busybox for PVC storage and to minio/mc for S3 storage
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// BackupPVCStorage references a PersistentVolumeClaim in the namespace of backup.
+type BackupPVCStorage struct {
+	// ClaimName is a name of PersistentVolumeClaim
+	// +kubebuilder:validation:Required
+	ClaimName string `json:"claimName"`
+
+	// Path is a directory inside the volume where backups are stored
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// BackupS3Storage references a bucket of S3 compatible object storage.
+type BackupS3Storage struct {
+	// Endpoint is an url of object storage, e.g. http://minio.minio.svc:9000
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// Bucket is a name of bucket
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+
+	// Path is a prefix inside the bucket where backups are stored
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// CredentialsSecret is a name of Secret with accessKey and secretKey keys
+	// +kubebuilder:validation:Required
+	CredentialsSecret string `json:"credentialsSecret"`
+
+	// Insecure disables TLS certificate verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// BackupVolumeSnapshotStorage defines how VolumeSnapshots of data volumes are taken.
+type BackupVolumeSnapshotStorage struct {
+	// ClassName is a name of VolumeSnapshotClass, default class of CSI driver is used when empty
+	// +optional
+	ClassName string `json:"className,omitempty"`
+}
+
+// BackupInstancesPolicy defines which instances of each replicaset are backed up.
+// +enum.
+type BackupInstancesPolicy string
+
+const (
+	BackupInstancesMaster BackupInstancesPolicy = "Master"
+	BackupInstancesAll    BackupInstancesPolicy = "All"
+)
+
+// TarantoolBackupSpec defines the desired state of TarantoolBackup.
+type TarantoolBackupSpec struct {
+	// Roles limits backup to replicasets of listed roles, all roles are backed up if empty
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Instances defines which instances of each replicaset are backed up
+	// +kubebuilder:validation:Enum=Master;All
+	// +kubebuilder:default=Master
+	// +optional
+	Instances BackupInstancesPolicy `json:"instances,omitempty"`
+
+	// Storage defines where backup files are stored
+	// +kubebuilder:validation:Required
+	Storage BackupStorage `json:"storage"`
+}
+
+// TarantoolBackupPhase is a label for the condition of a TarantoolBackup at the current time.
+// +enum.
+type TarantoolBackupPhase string
+
+const (
+	TarantoolBackupWaitingForCluster TarantoolBackupPhase = "WaitingForCluster"
+	TarantoolBackupWaitingForLeader  TarantoolBackupPhase = "WaitingForLeader"
+	TarantoolBackupSnapshotting      TarantoolBackupPhase = "Snapshotting"
+	TarantoolBackupUploading         TarantoolBackupPhase = "Uploading"
+	TarantoolBackupCompleted         TarantoolBackupPhase = "Completed"
+	TarantoolBackupFailed            TarantoolBackupPhase = "Failed"
+)
+
+// BackupInstance describes backed up files of a single instance.
+type BackupInstance struct {
+	// Pod is a name of instance pod
+	Pod string `json:"pod"`
+
+	// Role is a name of Role of instance
+	Role string `json:"role"`
+
+	// Replicaset is an alias of replicaset
+	Replicaset string `json:"replicaset"`
+
+	// ReplicasetUUID is an uuid of replicaset
+	ReplicasetUUID string `json:"replicasetUUID"`
+
+	// UUID is an uuid of instance
+	UUID string `json:"uuid"`
+
+	// Workdir is a working directory of instance
+	Workdir string `json:"workdir"`
+
+	// Volume is a name of volume claim template which contains working directory
+	Volume string `json:"volume"`
+
+	// MountPath is a path where volume is mounted
+	MountPath string `json:"mountPath"`
+
+	// Files is a list of snapshot and xlog files
+	Files []string `json:"files"`
+
+	// VolumeSnapshot is a name of VolumeSnapshot of data volume when backup is stored in volume snapshots
+	// +optional
+	VolumeSnapshot string `json:"volumeSnapshot,omitempty"`
+
+	// Uploaded indicates that files are copied to storage or volume snapshot is ready to use
+	// +optional
+	Uploaded bool `json:"uploaded,omitempty"`
+}
+
+// TarantoolBackupStatus defines the observed state of TarantoolBackup.
+type TarantoolBackupStatus struct {
+	// Phase indicates current state of TarantoolBackup
+	// +kubebuilder:default=Pending
+	Phase TarantoolBackupPhase `json:"phase"`
+
+	// StartedAt is the time when snapshots were made
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// CompletedAt is the time when all files were uploaded
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Location is a directory in storage which contains backup
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// TopologyConfigMap is a name of ConfigMap with recorded topology and clusterwide config
+	// +optional
+	TopologyConfigMap string `json:"topologyConfigMap,omitempty"`
+
+	// Instances contains backed up instances
+	// +optional
+	Instances []BackupInstance `json:"instances,omitempty"`
+
+	// Message describes the reason of failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TarantoolBackup is the Schema for the tarantoolbackups API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".status.location",priority=1
+// +kubebuilder:printcolumn:name="Completed",type="date",JSONPath=".status.completedAt",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TarantoolBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TarantoolBackupSpec   `json:"spec,omitempty"`
+	Status TarantoolBackupStatus `json:"status,omitempty"`
+}
+
+func (in *TarantoolBackup) SetPhase(phase TarantoolBackupPhase) {
+	in.Status.Phase = phase
+}
+
+func (in *TarantoolBackup) GetPhase() TarantoolBackupPhase {
+	return in.Status.Phase
+}
+
+func (in *TarantoolBackup) GetRoles() []string {
+	return in.Spec.Roles
+}
+
+func (in *TarantoolBackup) IsAllInstances() bool {
+	return in.Spec.Instances == BackupInstancesAll
+}
+
+func (in *TarantoolBackup) IsVolumeSnapshot() bool {
+	return in.Spec.Storage.VolumeSnapshot != nil
+}
+
+func (in *TarantoolBackup) IsCompleted() bool {
+	return in.Status.Phase == TarantoolBackupCompleted
+}
+
+func (in *TarantoolBackup) IsFinished() bool {
+	return in.Status.Phase == TarantoolBackupCompleted || in.Status.Phase == TarantoolBackupFailed
+}
+
+func (in *TarantoolBackup) IsSnapshotted() bool {
+	return in.Status.StartedAt != nil
+}
+
+func (in *TarantoolBackup) GetInstancePods() []string {
+	pods := make([]string, len(in.Status.Instances))
+	for k := range in.Status.Instances {
+		pods[k] = in.Status.Instances[k].Pod
+	}
+
+	return pods
+}
+
+func (in *TarantoolBackup) GetInstance(pod string) *BackupInstance {
+	for k := range in.Status.Instances {
+		if in.Status.Instances[k].Pod == pod {
+			return &in.Status.Instances[k]
+		}
+	}
+
+	return nil
+}
+
+func (in *TarantoolBackup) SetInstanceUploaded(pod string) {
+	if instance := in.GetInstance(pod); instance != nil {
+		instance.Uploaded = true
+	}
+}
+
+func (in *TarantoolBackup) IsInstanceUploaded(pod string) bool {
+	instance := in.GetInstance(pod)
+
+	return instance != nil && instance.Uploaded
+}
+
+func (in *TarantoolBackup) SetStartedAt(startedAt time.Time) {
+	t := metav1.NewTime(startedAt)
+	in.Status.StartedAt = &t
+}
+
+func (in *TarantoolBackup) SetCompletedAt(completedAt time.Time) {
+	t := metav1.NewTime(completedAt)
+	in.Status.CompletedAt = &t
+}
+
+func (in *TarantoolBackup) GetCompletedAt() time.Time {
+	if in.Status.CompletedAt == nil {
+		return time.Time{}
+	}
+
+	return in.Status.CompletedAt.Time
+}
+
+func (in *TarantoolBackup) SetMessage(message string) {
+	in.Status.Message = message
+}
+
+//+kubebuilder:object:root=true
+
+// TarantoolBackupList contains a list of TarantoolBackup.
+type TarantoolBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TarantoolBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TarantoolBackup{}, &TarantoolBackupList{})
+}
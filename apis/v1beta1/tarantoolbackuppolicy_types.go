@@ -0,0 +1,213 @@
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupRetention defines which finished backups are kept.
+// The most recent completed backup is never pruned.
+type BackupRetention struct {
+	// KeepLast is a number of most recent finished backups to keep, all backups are kept if not set
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// KeepFor is a duration to keep finished backups for, e.g. 168h, backups are kept forever if not set
+	// +optional
+	KeepFor *metav1.Duration `json:"keepFor,omitempty"`
+}
+
+// TarantoolBackupPolicySpec defines the desired state of TarantoolBackupPolicy.
+type TarantoolBackupPolicySpec struct {
+	// Schedule in cron format, e.g. "0 3 * * *" or "@daily"
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Suspend stops scheduling of new backups, already running backups are not affected
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Retention defines which finished backups are kept, pruned backups are removed from storage
+	// +optional
+	Retention BackupRetention `json:"retention,omitempty"`
+
+	// BackupTemplate is a spec of backups created by policy
+	// +kubebuilder:validation:Required
+	BackupTemplate TarantoolBackupSpec `json:"backupTemplate"`
+}
+
+// TarantoolBackupPolicyPhase is a label for the condition of a TarantoolBackupPolicy at the current time.
+// +enum.
+type TarantoolBackupPolicyPhase string
+
+const (
+	TarantoolBackupPolicyWaitingForCluster TarantoolBackupPolicyPhase = "WaitingForCluster"
+	TarantoolBackupPolicyScheduled         TarantoolBackupPolicyPhase = "Scheduled"
+	TarantoolBackupPolicySuspended         TarantoolBackupPolicyPhase = "Suspended"
+	TarantoolBackupPolicyInvalid           TarantoolBackupPolicyPhase = "Invalid"
+)
+
+// TarantoolBackupPolicyStatus defines the observed state of TarantoolBackupPolicy.
+type TarantoolBackupPolicyStatus struct {
+	// Phase indicates current state of TarantoolBackupPolicy
+	// +kubebuilder:default=Pending
+	Phase TarantoolBackupPolicyPhase `json:"phase"`
+
+	// LastScheduleTime is the time when backup was scheduled last time
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the time when next backup will be scheduled
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// LastSuccessfulTime is the time when the most recent completed backup was completed
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// LastSuccessfulBackup is a name of the most recent completed backup
+	// +optional
+	LastSuccessfulBackup string `json:"lastSuccessfulBackup,omitempty"`
+
+	// LastFailureTime is the time when the most recent failed backup was created
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// LastFailedBackup is a name of the most recent failed backup
+	// +optional
+	LastFailedBackup string `json:"lastFailedBackup,omitempty"`
+
+	// Active contains names of backups which are not finished yet
+	// +optional
+	Active []string `json:"active,omitempty"`
+
+	// Message describes the reason of invalid phase
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TarantoolBackupPolicy is the Schema for the tarantoolbackuppolicies API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",priority=0
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend",priority=0
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
+// +kubebuilder:printcolumn:name="Last Success",type="date",JSONPath=".status.lastSuccessfulTime",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TarantoolBackupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TarantoolBackupPolicySpec   `json:"spec,omitempty"`
+	Status TarantoolBackupPolicyStatus `json:"status,omitempty"`
+}
+
+func (in *TarantoolBackupPolicy) SetPhase(phase TarantoolBackupPolicyPhase) {
+	in.Status.Phase = phase
+}
+
+func (in *TarantoolBackupPolicy) GetPhase() TarantoolBackupPolicyPhase {
+	return in.Status.Phase
+}
+
+func (in *TarantoolBackupPolicy) GetSchedule() string {
+	return in.Spec.Schedule
+}
+
+func (in *TarantoolBackupPolicy) IsSuspended() bool {
+	return in.Spec.Suspend
+}
+
+func (in *TarantoolBackupPolicy) GetKeepLast() int32 {
+	if in.Spec.Retention.KeepLast == nil {
+		return 0
+	}
+
+	return *in.Spec.Retention.KeepLast
+}
+
+func (in *TarantoolBackupPolicy) GetKeepFor() time.Duration {
+	if in.Spec.Retention.KeepFor == nil {
+		return 0
+	}
+
+	return in.Spec.Retention.KeepFor.Duration
+}
+
+func (in *TarantoolBackupPolicy) GetLastScheduleTime() time.Time {
+	if in.Status.LastScheduleTime == nil {
+		return in.GetCreationTimestamp().Time
+	}
+
+	return in.Status.LastScheduleTime.Time
+}
+
+func (in *TarantoolBackupPolicy) SetLastScheduleTime(scheduleTime time.Time) {
+	t := metav1.NewTime(scheduleTime)
+	in.Status.LastScheduleTime = &t
+}
+
+func (in *TarantoolBackupPolicy) SetNextScheduleTime(scheduleTime time.Time) {
+	t := metav1.NewTime(scheduleTime)
+	in.Status.NextScheduleTime = &t
+}
+
+func (in *TarantoolBackupPolicy) GetNextScheduleTime() time.Time {
+	if in.Status.NextScheduleTime == nil {
+		return time.Time{}
+	}
+
+	return in.Status.NextScheduleTime.Time
+}
+
+func (in *TarantoolBackupPolicy) SetLastSuccessful(name string, completedAt time.Time) {
+	t := metav1.NewTime(completedAt)
+	in.Status.LastSuccessfulBackup = name
+	in.Status.LastSuccessfulTime = &t
+}
+
+func (in *TarantoolBackupPolicy) GetLastSuccessfulTime() time.Time {
+	if in.Status.LastSuccessfulTime == nil {
+		return time.Time{}
+	}
+
+	return in.Status.LastSuccessfulTime.Time
+}
+
+func (in *TarantoolBackupPolicy) SetLastFailed(name string, failedAt time.Time) {
+	t := metav1.NewTime(failedAt)
+	in.Status.LastFailedBackup = name
+	in.Status.LastFailureTime = &t
+}
+
+func (in *TarantoolBackupPolicy) GetLastFailureTime() time.Time {
+	if in.Status.LastFailureTime == nil {
+		return time.Time{}
+	}
+
+	return in.Status.LastFailureTime.Time
+}
+
+func (in *TarantoolBackupPolicy) SetActive(active []string) {
+	in.Status.Active = active
+}
+
+func (in *TarantoolBackupPolicy) SetMessage(message string) {
+	in.Status.Message = message
+}
+
+//+kubebuilder:object:root=true
+
+// TarantoolBackupPolicyList contains a list of TarantoolBackupPolicy.
+type TarantoolBackupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TarantoolBackupPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TarantoolBackupPolicy{}, &TarantoolBackupPolicyList{})
+}
@@ -0,0 +1,208 @@
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TarantoolRestoreSpec defines the desired state of TarantoolRestore.
+type TarantoolRestoreSpec struct {
+	// Backup is a name of completed TarantoolBackup in the backup namespace
+	// +kubebuilder:validation:Required
+	Backup string `json:"backup"`
+
+	// BackupNamespace is a namespace of TarantoolBackup, defaults to the namespace of restore.
+	// Backup of another namespace, cluster or roles is cloned into the cluster of restore,
+	// advertise URIs of instances are rewritten in downloaded clusterwide config.
+	// +optional
+	BackupNamespace string `json:"backupNamespace,omitempty"`
+
+	// Roles maps names of backed up roles to names of roles of the restored cluster,
+	// roles which are not listed keep their names
+	// +optional
+	Roles map[string]string `json:"roles,omitempty"`
+}
+
+// TarantoolRestorePhase is a label for the condition of a TarantoolRestore at the current time.
+// +enum.
+type TarantoolRestorePhase string
+
+const (
+	TarantoolRestoreWaitingForCluster TarantoolRestorePhase = "WaitingForCluster"
+	TarantoolRestoreWaitingForBackup  TarantoolRestorePhase = "WaitingForBackup"
+	TarantoolRestorePreparingVolumes  TarantoolRestorePhase = "PreparingVolumes"
+	TarantoolRestoreDownloading       TarantoolRestorePhase = "Downloading"
+	TarantoolRestoreCompleted         TarantoolRestorePhase = "Completed"
+	TarantoolRestoreFailed            TarantoolRestorePhase = "Failed"
+)
+
+// RestoreInstance describes restoring of a single instance.
+type RestoreInstance struct {
+	// Pod is a name of instance pod
+	Pod string `json:"pod"`
+
+	// BackupPod is a name of backed up instance pod when it differs from pod of restored instance
+	// +optional
+	BackupPod string `json:"backupPod,omitempty"`
+
+	// VolumeClaim is a name of PersistentVolumeClaim which is populated with backup files
+	VolumeClaim string `json:"volumeClaim"`
+
+	// Restored indicates that files are copied to the volume
+	// +optional
+	Restored bool `json:"restored,omitempty"`
+}
+
+// TarantoolRestoreStatus defines the observed state of TarantoolRestore.
+type TarantoolRestoreStatus struct {
+	// Phase indicates current state of TarantoolRestore
+	// +kubebuilder:default=Pending
+	Phase TarantoolRestorePhase `json:"phase"`
+
+	// CompletedAt is the time when all volumes were populated
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Instances contains restored instances
+	// +optional
+	Instances []RestoreInstance `json:"instances,omitempty"`
+
+	// Message describes the reason of failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// TarantoolRestore is the Schema for the tarantoolrestores API
+// Roles of the cluster do not create StatefulSets until restore is finished.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",priority=0
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backup",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type TarantoolRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TarantoolRestoreSpec   `json:"spec,omitempty"`
+	Status TarantoolRestoreStatus `json:"status,omitempty"`
+}
+
+func (in *TarantoolRestore) SetPhase(phase TarantoolRestorePhase) {
+	in.Status.Phase = phase
+}
+
+func (in *TarantoolRestore) GetPhase() TarantoolRestorePhase {
+	return in.Status.Phase
+}
+
+func (in *TarantoolRestore) GetBackupName() string {
+	return in.Spec.Backup
+}
+
+func (in *TarantoolRestore) GetBackupNamespace() string {
+	if in.Spec.BackupNamespace != "" {
+		return in.Spec.BackupNamespace
+	}
+
+	return in.GetNamespace()
+}
+
+// GetRestoredRoleName returns name of role of the restored cluster which replaces backed up role.
+func (in *TarantoolRestore) GetRestoredRoleName(role string) string {
+	if restored, ok := in.Spec.Roles[role]; ok && restored != "" {
+		return restored
+	}
+
+	return role
+}
+
+func (in *TarantoolRestore) IsFinished() bool {
+	return in.Status.Phase == TarantoolRestoreCompleted || in.Status.Phase == TarantoolRestoreFailed
+}
+
+func (in *TarantoolRestore) IsStarted() bool {
+	return len(in.Status.Instances) > 0
+}
+
+func (in *TarantoolRestore) AddInstance(pod, backupPod, volumeClaim string) {
+	for _, instance := range in.Status.Instances {
+		if instance.Pod == pod {
+			return
+		}
+	}
+
+	instance := RestoreInstance{
+		Pod:         pod,
+		VolumeClaim: volumeClaim,
+	}
+
+	if backupPod != pod {
+		instance.BackupPod = backupPod
+	}
+
+	in.Status.Instances = append(in.Status.Instances, instance)
+}
+
+// GetBackupPod returns name of backed up instance pod which is restored into pod.
+func (in *TarantoolRestore) GetBackupPod(pod string) string {
+	if instance := in.GetInstance(pod); instance != nil && instance.BackupPod != "" {
+		return instance.BackupPod
+	}
+
+	return pod
+}
+
+func (in *TarantoolRestore) GetInstancePods() []string {
+	pods := make([]string, len(in.Status.Instances))
+	for k := range in.Status.Instances {
+		pods[k] = in.Status.Instances[k].Pod
+	}
+
+	return pods
+}
+
+func (in *TarantoolRestore) GetInstance(pod string) *RestoreInstance {
+	for k := range in.Status.Instances {
+		if in.Status.Instances[k].Pod == pod {
+			return &in.Status.Instances[k]
+		}
+	}
+
+	return nil
+}
+
+func (in *TarantoolRestore) SetInstanceRestored(pod string) {
+	if instance := in.GetInstance(pod); instance != nil {
+		instance.Restored = true
+	}
+}
+
+func (in *TarantoolRestore) IsInstanceRestored(pod string) bool {
+	instance := in.GetInstance(pod)
+
+	return instance != nil && instance.Restored
+}
+
+func (in *TarantoolRestore) SetCompletedAt(completedAt time.Time) {
+	t := metav1.NewTime(completedAt)
+	in.Status.CompletedAt = &t
+}
+
+func (in *TarantoolRestore) SetMessage(message string) {
+	in.Status.Message = message
+}
+
+//+kubebuilder:object:root=true
+
+// TarantoolRestoreList contains a list of TarantoolRestore.
+type TarantoolRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TarantoolRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TarantoolRestore{}, &TarantoolRestoreList{})
+}
@@ -38,120 +38,1165 @@ SUCH DAMAGE.
 package v1beta1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupInstance) DeepCopyInto(out *BackupInstance) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupInstance.
+func (in *BackupInstance) DeepCopy() *BackupInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPVCStorage) DeepCopyInto(out *BackupPVCStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPVCStorage.
+func (in *BackupPVCStorage) DeepCopy() *BackupPVCStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPVCStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepFor != nil {
+		in, out := &in.KeepFor, &out.KeepFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupS3Storage) DeepCopyInto(out *BackupS3Storage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupS3Storage.
+func (in *BackupS3Storage) DeepCopy() *BackupS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorage) DeepCopyInto(out *BackupStorage) {
+	*out = *in
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(BackupPVCStorage)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(BackupS3Storage)
+		**out = **in
+	}
+	if in.VolumeSnapshot != nil {
+		in, out := &in.VolumeSnapshot, &out.VolumeSnapshot
+		*out = new(BackupVolumeSnapshotStorage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorage.
+func (in *BackupStorage) DeepCopy() *BackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeSnapshotStorage) DeepCopyInto(out *BackupVolumeSnapshotStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSnapshotStorage.
+func (in *BackupVolumeSnapshotStorage) DeepCopy() *BackupVolumeSnapshotStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeSnapshotStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CartridgeConfig) DeepCopyInto(out *CartridgeConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfig.
+func (in *CartridgeConfig) DeepCopy() *CartridgeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CartridgeConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigError) DeepCopyInto(out *CartridgeConfigError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigError.
+func (in *CartridgeConfigError) DeepCopy() *CartridgeConfigError {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigHistoryEntry) DeepCopyInto(out *CartridgeConfigHistoryEntry) {
+	*out = *in
+	in.AppliedAt.DeepCopyInto(&out.AppliedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigHistoryEntry.
+func (in *CartridgeConfigHistoryEntry) DeepCopy() *CartridgeConfigHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigKeyToSection) DeepCopyInto(out *CartridgeConfigKeyToSection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigKeyToSection.
+func (in *CartridgeConfigKeyToSection) DeepCopy() *CartridgeConfigKeyToSection {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigKeyToSection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigList) DeepCopyInto(out *CartridgeConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CartridgeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigList.
+func (in *CartridgeConfigList) DeepCopy() *CartridgeConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CartridgeConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigObjectSource) DeepCopyInto(out *CartridgeConfigObjectSource) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CartridgeConfigKeyToSection, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigObjectSource.
+func (in *CartridgeConfigObjectSource) DeepCopy() *CartridgeConfigObjectSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigObjectSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigSource) DeepCopyInto(out *CartridgeConfigSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(CartridgeConfigObjectSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(CartridgeConfigObjectSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigSource.
+func (in *CartridgeConfigSource) DeepCopy() *CartridgeConfigSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigSpec) DeepCopyInto(out *CartridgeConfigSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]CartridgeConfigSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftCheckInterval != nil {
+		in, out := &in.DriftCheckInterval, &out.DriftCheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigSpec.
+func (in *CartridgeConfigSpec) DeepCopy() *CartridgeConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeConfigStatus) DeepCopyInto(out *CartridgeConfigStatus) {
+	*out = *in
+	if in.OwnedSections != nil {
+		in, out := &in.OwnedSections, &out.OwnedSections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]CartridgeConfigHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(CartridgeConfigError)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigStatus.
+func (in *CartridgeConfigStatus) DeepCopy() *CartridgeConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeSchema) DeepCopyInto(out *CartridgeSchema) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeSchema.
+func (in *CartridgeSchema) DeepCopy() *CartridgeSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CartridgeSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeSchemaError) DeepCopyInto(out *CartridgeSchemaError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeSchemaError.
+func (in *CartridgeSchemaError) DeepCopy() *CartridgeSchemaError {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeSchemaError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeSchemaList) DeepCopyInto(out *CartridgeSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CartridgeSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeSchemaList.
+func (in *CartridgeSchemaList) DeepCopy() *CartridgeSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CartridgeSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeSchemaSpec) DeepCopyInto(out *CartridgeSchemaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeSchemaSpec.
+func (in *CartridgeSchemaSpec) DeepCopy() *CartridgeSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CartridgeSchemaStatus) DeepCopyInto(out *CartridgeSchemaStatus) {
+	*out = *in
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(CartridgeSchemaError)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeSchemaStatus.
+func (in *CartridgeSchemaStatus) DeepCopy() *CartridgeSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CartridgeSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cluster) DeepCopyInto(out *Cluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
+	if in == nil {
+		return nil
+	}
+	out := new(Cluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMetrics) DeepCopyInto(out *ClusterMetrics) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMetrics.
+func (in *ClusterMetrics) DeepCopy() *ClusterMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	in.Failover.DeepCopyInto(&out.Failover)
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ClusterMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ClusterTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tarantool3 != nil {
+		in, out := &in.Tarantool3, &out.Tarantool3
+		*out = new(ClusterTarantool3)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTLS) DeepCopyInto(out *ClusterTLS) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(ClusterTLSIssuerRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTLS.
+func (in *ClusterTLS) DeepCopy() *ClusterTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTLSIssuerRef) DeepCopyInto(out *ClusterTLSIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTLSIssuerRef.
+func (in *ClusterTLSIssuerRef) DeepCopy() *ClusterTLSIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTLSIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTarantool3) DeepCopyInto(out *ClusterTarantool3) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]ClusterUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTarantool3.
+func (in *ClusterTarantool3) DeepCopy() *ClusterTarantool3 {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTarantool3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUser) DeepCopyInto(out *ClusterUser) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordSecretKeyRef != nil {
+		in, out := &in.PasswordSecretKeyRef, &out.PasswordSecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUser.
+func (in *ClusterUser) DeepCopy() *ClusterUser {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverConfig) DeepCopyInto(out *FailoverConfig) {
+	*out = *in
+	if in.Etcd2 != nil {
+		in, out := &in.Etcd2, &out.Etcd2
+		*out = new(FailoverEtcd2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stateboard != nil {
+		in, out := &in.Stateboard, &out.Stateboard
+		*out = new(FailoverStateboard)
+		**out = **in
+	}
+	if in.Raft != nil {
+		in, out := &in.Raft, &out.Raft
+		*out = new(FailoverRaft)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverConfig.
+func (in *FailoverConfig) DeepCopy() *FailoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverEtcd2) DeepCopyInto(out *FailoverEtcd2) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Password = in.Password
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverEtcd2.
+func (in *FailoverEtcd2) DeepCopy() *FailoverEtcd2 {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverEtcd2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverRaft) DeepCopyInto(out *FailoverRaft) {
+	*out = *in
+	if in.ElectionTimeout != nil {
+		in, out := &in.ElectionTimeout, &out.ElectionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SynchroQuorum != nil {
+		in, out := &in.SynchroQuorum, &out.SynchroQuorum
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverRaft.
+func (in *FailoverRaft) DeepCopy() *FailoverRaft {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverRaft)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverStateboard) DeepCopyInto(out *FailoverStateboard) {
+	*out = *in
+	out.Password = in.Password
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverStateboard.
+func (in *FailoverStateboard) DeepCopy() *FailoverStateboard {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverStateboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicasetTemplate) DeepCopyInto(out *ReplicasetTemplate) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.PodTemplate.DeepCopyInto(&out.PodTemplate)
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]corev1.PersistentVolumeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.UpdateStrategy.DeepCopyInto(&out.UpdateStrategy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicasetTemplate.
+func (in *ReplicasetTemplate) DeepCopy() *ReplicasetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicasetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreInstance) DeepCopyInto(out *RestoreInstance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreInstance.
+func (in *RestoreInstance) DeepCopy() *RestoreInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleElectionStatus) DeepCopyInto(out *RoleElectionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleElectionStatus.
+func (in *RoleElectionStatus) DeepCopy() *RoleElectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleElectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleInstanceStatus) DeepCopyInto(out *RoleInstanceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleInstanceStatus.
+func (in *RoleInstanceStatus) DeepCopy() *RoleInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePlacement) DeepCopyInto(out *RolePlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePlacement.
+func (in *RolePlacement) DeepCopy() *RolePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleReplication) DeepCopyInto(out *RoleReplication) {
+	*out = *in
+	if in.SynchroQuorum != nil {
+		in, out := &in.SynchroQuorum, &out.SynchroQuorum
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.SynchroTimeout != nil {
+		in, out := &in.SynchroTimeout, &out.SynchroTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConnectQuorum != nil {
+		in, out := &in.ConnectQuorum, &out.ConnectQuorum
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleReplication.
+func (in *RoleReplication) DeepCopy() *RoleReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleService) DeepCopyInto(out *RoleService) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleService.
+func (in *RoleService) DeepCopy() *RoleService {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	if in.Replicasets != nil {
+		in, out := &in.Replicasets, &out.Replicasets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicasetTemplate != nil {
+		in, out := &in.ReplicasetTemplate, &out.ReplicasetTemplate
+		*out = new(ReplicasetTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(RolePlacement)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(RoleService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StoragePolicy != nil {
+		in, out := &in.StoragePolicy, &out.StoragePolicy
+		*out = new(RoleStoragePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.VShard.DeepCopyInto(&out.VShard)
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(RoleReplication)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfig.
-func (in *CartridgeConfig) DeepCopy() *CartridgeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CartridgeConfig)
+	out := new(RoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CartridgeConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CartridgeConfigList) DeepCopyInto(out *CartridgeConfigList) {
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]CartridgeConfig, len(*in))
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]RoleInstanceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]RoleVolumeStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastScaleOutTime != nil {
+		in, out := &in.LastScaleOutTime, &out.LastScaleOutTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Elections != nil {
+		in, out := &in.Elections, &out.Elections
+		*out = make([]RoleElectionStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigList.
-func (in *CartridgeConfigList) DeepCopy() *CartridgeConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CartridgeConfigList)
+	out := new(RoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CartridgeConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStoragePolicy) DeepCopyInto(out *RoleStoragePolicy) {
+	*out = *in
+	if in.VinylDiskLimit != nil {
+		in, out := &in.VinylDiskLimit, &out.VinylDiskLimit
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	return nil
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStoragePolicy.
+func (in *RoleStoragePolicy) DeepCopy() *RoleStoragePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStoragePolicy)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CartridgeConfigSpec) DeepCopyInto(out *CartridgeConfigSpec) {
+func (in *RoleVShardConfig) DeepCopyInto(out *RoleVShardConfig) {
 	*out = *in
+	if in.ClusterRoles != nil {
+		in, out := &in.ClusterRoles, &out.ClusterRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigSpec.
-func (in *CartridgeConfigSpec) DeepCopy() *CartridgeConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleVShardConfig.
+func (in *RoleVShardConfig) DeepCopy() *RoleVShardConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CartridgeConfigSpec)
+	out := new(RoleVShardConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CartridgeConfigStatus) DeepCopyInto(out *CartridgeConfigStatus) {
+func (in *RoleVolumeStatus) DeepCopyInto(out *RoleVolumeStatus) {
 	*out = *in
+	out.Requested = in.Requested.DeepCopy()
+	out.Capacity = in.Capacity.DeepCopy()
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CartridgeConfigStatus.
-func (in *CartridgeConfigStatus) DeepCopy() *CartridgeConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleVolumeStatus.
+func (in *RoleVolumeStatus) DeepCopy() *RoleVolumeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CartridgeConfigStatus)
+	out := new(RoleVolumeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cluster) DeepCopyInto(out *Cluster) {
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TarantoolBackup) DeepCopyInto(out *TarantoolBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
-func (in *Cluster) DeepCopy() *Cluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackup.
+func (in *TarantoolBackup) DeepCopy() *TarantoolBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(Cluster)
+	out := new(TarantoolBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cluster) DeepCopyObject() runtime.Object {
+func (in *TarantoolBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -159,31 +1204,31 @@ func (in *Cluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *TarantoolBackupList) DeepCopyInto(out *TarantoolBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
+		*out = make([]TarantoolBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupList.
+func (in *TarantoolBackupList) DeepCopy() *TarantoolBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(TarantoolBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
+func (in *TarantoolBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -191,148 +1236,189 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+func (in *TarantoolBackupPolicy) DeepCopyInto(out *TarantoolBackupPolicy) {
 	*out = *in
-	in.Failover.DeepCopyInto(&out.Failover)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
-func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupPolicy.
+func (in *TarantoolBackupPolicy) DeepCopy() *TarantoolBackupPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterSpec)
+	out := new(TarantoolBackupPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TarantoolBackupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+func (in *TarantoolBackupPolicyList) DeepCopyInto(out *TarantoolBackupPolicyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TarantoolBackupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
-func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupPolicyList.
+func (in *TarantoolBackupPolicyList) DeepCopy() *TarantoolBackupPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStatus)
+	out := new(TarantoolBackupPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TarantoolBackupPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailoverConfig) DeepCopyInto(out *FailoverConfig) {
+func (in *TarantoolBackupPolicySpec) DeepCopyInto(out *TarantoolBackupPolicySpec) {
 	*out = *in
-	if in.Etcd2 != nil {
-		in, out := &in.Etcd2, &out.Etcd2
-		*out = new(FailoverEtcd2)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Stateboard != nil {
-		in, out := &in.Stateboard, &out.Stateboard
-		*out = new(FailoverStateboard)
-		**out = **in
-	}
+	in.Retention.DeepCopyInto(&out.Retention)
+	in.BackupTemplate.DeepCopyInto(&out.BackupTemplate)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverConfig.
-func (in *FailoverConfig) DeepCopy() *FailoverConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupPolicySpec.
+func (in *TarantoolBackupPolicySpec) DeepCopy() *TarantoolBackupPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FailoverConfig)
+	out := new(TarantoolBackupPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailoverEtcd2) DeepCopyInto(out *FailoverEtcd2) {
+func (in *TarantoolBackupPolicyStatus) DeepCopyInto(out *TarantoolBackupPolicyStatus) {
 	*out = *in
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.Password = in.Password
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverEtcd2.
-func (in *FailoverEtcd2) DeepCopy() *FailoverEtcd2 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupPolicyStatus.
+func (in *TarantoolBackupPolicyStatus) DeepCopy() *TarantoolBackupPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FailoverEtcd2)
+	out := new(TarantoolBackupPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailoverStateboard) DeepCopyInto(out *FailoverStateboard) {
+func (in *TarantoolBackupSpec) DeepCopyInto(out *TarantoolBackupSpec) {
 	*out = *in
-	out.Password = in.Password
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverStateboard.
-func (in *FailoverStateboard) DeepCopy() *FailoverStateboard {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupSpec.
+func (in *TarantoolBackupSpec) DeepCopy() *TarantoolBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FailoverStateboard)
+	out := new(TarantoolBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicasetTemplate) DeepCopyInto(out *ReplicasetTemplate) {
+func (in *TarantoolBackupStatus) DeepCopyInto(out *TarantoolBackupStatus) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
 	}
-	in.PodTemplate.DeepCopyInto(&out.PodTemplate)
-	if in.VolumeClaimTemplates != nil {
-		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
-		*out = make([]v1.PersistentVolumeClaim, len(*in))
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]BackupInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.UpdateStrategy.DeepCopyInto(&out.UpdateStrategy)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicasetTemplate.
-func (in *ReplicasetTemplate) DeepCopy() *ReplicasetTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolBackupStatus.
+func (in *TarantoolBackupStatus) DeepCopy() *TarantoolBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicasetTemplate)
+	out := new(TarantoolBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Role) DeepCopyInto(out *Role) {
+func (in *TarantoolRestore) DeepCopyInto(out *TarantoolRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
-func (in *Role) DeepCopy() *Role {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolRestore.
+func (in *TarantoolRestore) DeepCopy() *TarantoolRestore {
 	if in == nil {
 		return nil
 	}
-	out := new(Role)
+	out := new(TarantoolRestore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Role) DeepCopyObject() runtime.Object {
+func (in *TarantoolRestore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -340,31 +1426,31 @@ func (in *Role) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleList) DeepCopyInto(out *RoleList) {
+func (in *TarantoolRestoreList) DeepCopyInto(out *TarantoolRestoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Role, len(*in))
+		*out = make([]TarantoolRestore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
-func (in *RoleList) DeepCopy() *RoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolRestoreList.
+func (in *TarantoolRestoreList) DeepCopy() *TarantoolRestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleList)
+	out := new(TarantoolRestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoleList) DeepCopyObject() runtime.Object {
+func (in *TarantoolRestoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -372,77 +1458,47 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+func (in *TarantoolRestoreSpec) DeepCopyInto(out *TarantoolRestoreSpec) {
 	*out = *in
-	if in.Replicasets != nil {
-		in, out := &in.Replicasets, &out.Replicasets
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ReplicasetTemplate != nil {
-		in, out := &in.ReplicasetTemplate, &out.ReplicasetTemplate
-		*out = new(ReplicasetTemplate)
-		(*in).DeepCopyInto(*out)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	in.VShard.DeepCopyInto(&out.VShard)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
-func (in *RoleSpec) DeepCopy() *RoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolRestoreSpec.
+func (in *TarantoolRestoreSpec) DeepCopy() *TarantoolRestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleSpec)
+	out := new(TarantoolRestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+func (in *TarantoolRestoreStatus) DeepCopyInto(out *TarantoolRestoreStatus) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
-func (in *RoleStatus) DeepCopy() *RoleStatus {
-	if in == nil {
-		return nil
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
 	}
-	out := new(RoleStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleVShardConfig) DeepCopyInto(out *RoleVShardConfig) {
-	*out = *in
-	if in.ClusterRoles != nil {
-		in, out := &in.ClusterRoles, &out.ClusterRoles
-		*out = make([]string, len(*in))
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]RestoreInstance, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleVShardConfig.
-func (in *RoleVShardConfig) DeepCopy() *RoleVShardConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(RoleVShardConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
-func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarantoolRestoreStatus.
+func (in *TarantoolRestoreStatus) DeepCopy() *TarantoolRestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeyReference)
+	out := new(TarantoolRestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
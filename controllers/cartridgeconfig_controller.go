@@ -9,6 +9,7 @@ import (
 	. "github.com/tarantool/tarantool-operator/internal"
 	"github.com/tarantool/tarantool-operator/internal/implementation"
 	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/api"
 	"github.com/tarantool/tarantool-operator/pkg/election"
 	"github.com/tarantool/tarantool-operator/pkg/events"
 	"github.com/tarantool/tarantool-operator/pkg/k8s"
@@ -17,9 +18,16 @@ import (
 	"github.com/tarantool/tarantool-operator/pkg/topology"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 //+kubebuilder:rbac:groups=tarantool.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
@@ -27,6 +35,8 @@ import (
 //+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeconfigs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeconfigs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 
 func NewCartridgeConfigReconciler(mgr Manager) *CartridgeConfigReconciler {
 	k8sConfig := mgr.GetConfig()
@@ -76,12 +86,12 @@ func NewCartridgeConfigReconciler(mgr Manager) *CartridgeConfigReconciler {
 							Client:           k8sClient,
 							Recorder:         eventsRecorder,
 							ResourcesManager: resourcesManager,
-							Topology:         luaTopology,
+							Membership:       luaTopology,
 						},
-						ResourcesManager: resourcesManager,
-						EventsRecorder:   eventsRecorder,
-						Topology:         luaTopology,
-						LabelsManager:    labelsManager,
+						ResourcesManager:  resourcesManager,
+						EventsRecorder:    eventsRecorder,
+						ClusterwideConfig: luaTopology,
+						LabelsManager:     labelsManager,
 					},
 				},
 			},
@@ -108,8 +118,11 @@ func (r *CartridgeConfigReconciler) Reconcile(ctx context.Context, req Request)
 		Info[*CartridgeConfigContextCE, *CartridgeConfigControllerCE]("Reconcile Config"),
 		GetRequestedObject[*CartridgeConfigContextCE, *CartridgeConfigControllerCE](&CartridgeConfig{}),
 		ResetCartridgeConfigStatus(),
+		SetCartridgeConfigPhase(CartridgeConfigLoadingSources),
+		LoadDesiredCartridgeConfig(),
 		SetCartridgeConfigPhase(CartridgeConfigWaitingForCluster),
 		GetClusterByLabels[*CartridgeConfigContextCE, *CartridgeConfigControllerCE](),
+		ResolveCartridgeConfigOwnership(),
 		WaitForClusterBootstrapped[*CartridgeConfigContextCE, *CartridgeConfigControllerCE](),
 		SetCartridgeConfigPhase(CartridgeConfigWaitingForLeader),
 		GetLeader[*CartridgeConfigContextCE, *CartridgeConfigControllerCE](),
@@ -117,6 +130,7 @@ func (r *CartridgeConfigReconciler) Reconcile(ctx context.Context, req Request)
 		ConfigureCartridge(),
 		SetCartridgeConfigPhase(CartridgeConfigReady),
 		Info[*CartridgeConfigContextCE, *CartridgeConfigControllerCE]("CartridgeConfig ready"),
+		ScheduleCartridgeConfigDriftCheck(),
 	)
 }
 
@@ -124,5 +138,79 @@ func (r *CartridgeConfigReconciler) Reconcile(ctx context.Context, req Request)
 func (r *CartridgeConfigReconciler) SetupWithManager(mgr Manager) error {
 	return NewControllerManagedBy(mgr).
 		For(&CartridgeConfig{}).
+		Watches(
+			&CartridgeConfig{},
+			r.enqueueSiblingConfigs(mgr.GetClient()),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(&v1.ConfigMap{}, r.enqueueConfigsWithSource(mgr.GetClient(), api.CartridgeConfigSourceConfigMap)).
+		Watches(&v1.Secret{}, r.enqueueConfigsWithSource(mgr.GetClient(), api.CartridgeConfigSourceSecret)).
 		Complete(r)
 }
+
+// enqueueSiblingConfigs requeues every other CartridgeConfig of the same cluster,
+// because changes of one CartridgeConfig may change the ownership of sections.
+func (r *CartridgeConfigReconciler) enqueueSiblingConfigs(k8sClient client.Client) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		clusterName, ok := obj.GetLabels()[r.Controller.GetLabelsManager().ClusterName()]
+		if !ok {
+			return []Request{}
+		}
+
+		configs := &CartridgeConfigList{}
+
+		err := k8sClient.List(
+			ctx,
+			configs,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingLabels{r.Controller.GetLabelsManager().ClusterName(): clusterName},
+		)
+		if err != nil {
+			return []Request{}
+		}
+
+		var requests []Request
+
+		for i := range configs.Items {
+			config := &configs.Items[i]
+			if config.GetName() != obj.GetName() {
+				requests = append(requests, Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: config.GetNamespace(),
+						Name:      config.GetName(),
+					},
+				})
+			}
+		}
+
+		return requests
+	})
+}
+
+// enqueueConfigsWithSource requeues every CartridgeConfig which refers to the changed ConfigMap or Secret.
+func (r *CartridgeConfigReconciler) enqueueConfigsWithSource(k8sClient client.Client, kind api.CartridgeConfigSourceKind) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		configs := &CartridgeConfigList{}
+
+		err := k8sClient.List(ctx, configs, client.InNamespace(obj.GetNamespace()))
+		if err != nil {
+			return []Request{}
+		}
+
+		var requests []Request
+
+		for i := range configs.Items {
+			config := &configs.Items[i]
+			if config.HasSource(kind, obj.GetName()) {
+				requests = append(requests, Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: config.GetNamespace(),
+						Name:      config.GetName(),
+					},
+				})
+			}
+		}
+
+		return requests
+	})
+}
@@ -0,0 +1,613 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	pkgutils "github.com/tarantool/tarantool-operator/pkg/utils"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCartridgeConfigReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *CartridgeConfigReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &CartridgeConfigReconciler{
+		SteppedReconciler: &reconciliation.SteppedReconciler[*CartridgeConfigContextCE, *CartridgeConfigControllerCE]{
+			Client: fakeClient,
+			Controller: &CartridgeConfigControllerCE{
+				CommonCartridgeConfigController: &reconciliation.CommonCartridgeConfigController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager:  resourcesManager,
+						LabelsManager:     labelsManager,
+						EventsRecorder:    eventsRecorder,
+						ClusterwideConfig: fakeTopologyService,
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("cartridgeconfig_controller unit testing", func() {
+	var (
+		ctx         = context.Background()
+		namespace   = "default"
+		clusterName string
+		configName  string
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		configName = fmt.Sprintf("config-%s", utils.RandStringRunes(4))
+	})
+
+	Context("config sources", func() {
+		var cartridge *resources.FakeCartridge
+		var fakeTopologyService *mocks.FakeCartridgeTopology
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithRouterStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithAllPodsRunning().
+				Bootstrapped()
+			cartridge.WithLeader(cartridge.Pods[0].GetName())
+
+			fakeTopologyService = mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.
+				On("IsConfigured", mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			fakeTopologyService.
+				On("GetConfig", mock.Anything, mock.Anything).
+				Return(topology.ClusterwideConfigData{}, nil)
+		})
+
+		It("must merge data with sections from ConfigMap and Secret", func() {
+			cartridge.
+				WithConfigMap("app-config", map[string]string{
+					"app":     "timeout: 10",
+					"metrics": "export: []",
+				}).
+				WithSecret("app-secrets", map[string][]byte{
+					"password": []byte("secret"),
+				}).
+				WithCartridgeConfig(configName, "app:\n  timeout: 5\nlimits:\n  rps: 100\n",
+					v1beta1.CartridgeConfigSource{
+						ConfigMap: &v1beta1.CartridgeConfigObjectSource{
+							Name: "app-config",
+						},
+					},
+					v1beta1.CartridgeConfigSource{
+						Secret: &v1beta1.CartridgeConfigObjectSource{
+							Name: "app-secrets",
+							Items: []v1beta1.CartridgeConfigKeyToSection{
+								{Key: "password", Section: "credentials"},
+							},
+						},
+					},
+				)
+
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, topology.ClusterwideConfigData{
+					"app":         map[string]any{"timeout": 10},
+					"limits":      map[string]any{"rps": 100},
+					"metrics":     map[string]any{"export": []any{}},
+					"credentials": "secret",
+				}).
+				Return(nil).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			fakeTopologyService.AssertExpectations(GinkgoT())
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigReady))
+		})
+
+		It("must not apply config if required source is missing", func() {
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: 5\n",
+				v1beta1.CartridgeConfigSource{
+					ConfigMap: &v1beta1.CartridgeConfigObjectSource{
+						Name: "absent",
+					},
+				},
+			)
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).To(HaveOccurred(), "missing source must fail reconcile")
+			Expect(result.RequeueAfter).NotTo(BeZero(), "should be re-queued")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigLoadingSources))
+		})
+
+		It("must reject source which maps keys to the same section", func() {
+			cartridge.
+				WithConfigMap("app-config", map[string]string{
+					"first":  "timeout: 5",
+					"second": "timeout: 10",
+				}).
+				WithCartridgeConfig(configName, "",
+					v1beta1.CartridgeConfigSource{
+						ConfigMap: &v1beta1.CartridgeConfigObjectSource{
+							Name: "app-config",
+							Items: []v1beta1.CartridgeConfigKeyToSection{
+								{Key: "first", Section: "app"},
+								{Key: "second", Section: "app"},
+							},
+						},
+					},
+				)
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).To(MatchError(ContainSubstring("to the same section app")))
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("must reject source without ConfigMap and Secret", func() {
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: 5\n", v1beta1.CartridgeConfigSource{})
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).To(MatchError(ContainSubstring("exactly one of configMap or secret")))
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("must skip missing optional source", func() {
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: 5\n",
+				v1beta1.CartridgeConfigSource{
+					Secret: &v1beta1.CartridgeConfigObjectSource{
+						Name:     "absent",
+						Optional: true,
+					},
+				},
+			)
+
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, topology.ClusterwideConfigData{
+					"app": map[string]any{"timeout": 5},
+				}).
+				Return(nil).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			fakeTopologyService.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Context("sections ownership", func() {
+		var cartridge *resources.FakeCartridge
+		var fakeTopologyService *mocks.FakeCartridgeTopology
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithRouterStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithAllPodsRunning().
+				Bootstrapped()
+			cartridge.WithLeader(cartridge.Pods[0].GetName())
+
+			fakeTopologyService = mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.
+				On("IsConfigured", mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			fakeTopologyService.
+				On("GetConfig", mock.Anything, mock.Anything).
+				Return(topology.ClusterwideConfigData{}, nil)
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+		})
+
+		getConfig := func(fakeClient client.Client, name string) *v1beta1.CartridgeConfig {
+			config := &v1beta1.CartridgeConfig{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+
+			return config
+		}
+
+		It("must reject config which declares section owned by config with higher priority", func() {
+			lowName := configName + "-low"
+			highName := configName + "-high"
+
+			cartridge.
+				WithCartridgeConfig(lowName, "app:\n  timeout: 5\n").
+				WithCartridgeConfig(highName, "app:\n  timeout: 10\nlimits:\n  rps: 100\n")
+			cartridge.CartridgeConfigs[1].Spec.Priority = 10
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, lowName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			Expect(result.RequeueAfter).To(BeZero(), "should not be re-queued")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			lowConfig := getConfig(fakeClient, lowName)
+			Expect(lowConfig.Status.Phase).To(Equal(v1beta1.CartridgeConfigConflict))
+			Expect(lowConfig.Status.OwnedSections).To(BeEmpty())
+
+			_, err = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, highName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			highConfig := getConfig(fakeClient, highName)
+			Expect(highConfig.Status.Phase).To(Equal(v1beta1.CartridgeConfigReady))
+			Expect(highConfig.Status.OwnedSections).To(Equal([]string{"app", "limits"}))
+		})
+
+		It("must use name to order configs with the same priority", func() {
+			firstName := configName + "-a"
+			secondName := configName + "-b"
+
+			cartridge.
+				WithCartridgeConfig(secondName, "app:\n  timeout: 10\n").
+				WithCartridgeConfig(firstName, "app:\n  timeout: 5\n")
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, firstName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			_, err = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, secondName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			Expect(getConfig(fakeClient, firstName).Status.Phase).To(Equal(v1beta1.CartridgeConfigReady))
+			Expect(getConfig(fakeClient, secondName).Status.Phase).To(Equal(v1beta1.CartridgeConfigConflict))
+		})
+
+		It("must apply configs with disjoint sections", func() {
+			firstName := configName + "-a"
+			secondName := configName + "-b"
+
+			cartridge.
+				WithCartridgeConfig(firstName, "app:\n  timeout: 5\n").
+				WithCartridgeConfig(secondName, "limits:\n  rps: 100\n")
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, firstName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			_, err = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, secondName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			Expect(getConfig(fakeClient, firstName).Status.OwnedSections).To(Equal([]string{"app"}))
+			Expect(getConfig(fakeClient, secondName).Status.OwnedSections).To(Equal([]string{"limits"}))
+			fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "ApplyConfig", 2)
+		})
+	})
+
+	Context("drift detection", func() {
+		var cartridge *resources.FakeCartridge
+		var fakeTopologyService *mocks.FakeCartridgeTopology
+		var desiredHash string
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithRouterStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithAllPodsRunning().
+				Bootstrapped()
+			cartridge.WithLeader(cartridge.Pods[0].GetName())
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: 5\n")
+
+			var err error
+			desiredHash, err = pkgutils.HashObject(topology.ClusterwideConfigData{"app": map[string]any{"timeout": 5}})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeTopologyService = mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.
+				On("IsConfigured", mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			fakeTopologyService.
+				On("GetConfig", mock.Anything, mock.Anything).
+				Return(topology.ClusterwideConfigData{"app": map[string]any{"timeout": float64(7)}}, nil)
+		})
+
+		It("must revert drift of previously applied config", func() {
+			cartridge.CartridgeConfigs[0].Status.History = []v1beta1.CartridgeConfigHistoryEntry{
+				{Hash: desiredHash, AppliedAt: metav1.Now()},
+			}
+
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			Expect(result.RequeueAfter).To(Equal(v1beta1.DefaultCartridgeConfigDriftCheckInterval), "drift check must be scheduled")
+
+			fakeTopologyService.AssertExpectations(GinkgoT())
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigReady))
+			Expect(config.Status.History).To(HaveLen(2))
+			Expect(config.Status.History[0].Hash).To(Equal(desiredHash))
+			Expect(meta.IsStatusConditionFalse(config.Status.Conditions, v1beta1.CartridgeConfigConditionDrifted)).To(BeTrue())
+		})
+
+		It("must only report drift in observe-only mode", func() {
+			cartridge.CartridgeConfigs[0].Spec.Mode = v1beta1.CartridgeConfigModeObserveOnly
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			Expect(result.RequeueAfter).To(Equal(v1beta1.DefaultCartridgeConfigDriftCheckInterval), "drift check must be scheduled")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigDrifted))
+
+			condition := meta.FindStatusCondition(config.Status.Conditions, v1beta1.CartridgeConfigConditionDrifted)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("app.timeout"))
+		})
+
+		It("must keep limited history", func() {
+			historyLimit := int32(2)
+			cartridge.CartridgeConfigs[0].Spec.HistoryLimit = &historyLimit
+			cartridge.CartridgeConfigs[0].Status.History = []v1beta1.CartridgeConfigHistoryEntry{
+				{Hash: "second", AppliedAt: metav1.Now()},
+				{Hash: "first", AppliedAt: metav1.Now()},
+			}
+
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.History).To(HaveLen(2))
+			Expect(config.Status.History[0].Hash).To(Equal(desiredHash))
+			Expect(config.Status.History[1].Hash).To(Equal("second"))
+		})
+	})
+
+	Context("config validation", func() {
+		var cartridge *resources.FakeCartridge
+		var fakeTopologyService *mocks.FakeCartridgeTopology
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithRouterStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithAllPodsRunning().
+				Bootstrapped()
+			cartridge.WithLeader(cartridge.Pods[0].GetName())
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: -1\n")
+
+			fakeTopologyService = mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.
+				On("IsConfigured", mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeTopologyService.
+				On("GetConfig", mock.Anything, mock.Anything).
+				Return(topology.ClusterwideConfigData{}, nil)
+		})
+
+		It("must not apply config rejected by application", func() {
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(topology.NewConfigValidationError(&topology.LuaError{
+					ClassName: "ValidateConfigError",
+					Err:       "timeout must be positive",
+				})).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			Expect(result.RequeueAfter).To(BeZero(), "should not be re-queued")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigInvalid))
+			Expect(config.Status.Error).To(Equal(&v1beta1.CartridgeConfigError{
+				ClassName: "ValidateConfigError",
+				Message:   "timeout must be positive",
+			}))
+		})
+
+		It("must wait until cluster-wide config is active on leader", func() {
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(topology.ErrConfigNotActive).
+				Once()
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			result, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+			Expect(result.RequeueAfter).NotTo(BeZero(), "should be re-queued")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).NotTo(Equal(v1beta1.CartridgeConfigInvalid))
+			Expect(config.Status.Error).To(BeNil())
+		})
+
+		It("must surface lua error returned on apply", func() {
+			fakeTopologyService.
+				On("ValidateConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+			fakeTopologyService.
+				On("ApplyConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(fmt.Errorf("failed to upload cartridge config: %w", &topology.LuaError{
+					ClassName: "Prepare2pcError",
+					Err:       "instance unreachable",
+				}))
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).To(HaveOccurred(), "apply error must fail reconcile")
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigApplying))
+			Expect(config.Status.History).To(BeEmpty())
+			Expect(config.Status.Error.ClassName).To(Equal("Prepare2pcError"))
+		})
+	})
+
+	Context("applied config", func() {
+		It("must switch to Ready when config is already applied", func() {
+			cartridge := resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithRouterStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithAllPodsRunning().
+				Bootstrapped()
+			cartridge.WithLeader(cartridge.Pods[0].GetName())
+			cartridge.WithCartridgeConfig(configName, "app:\n  timeout: 5\n")
+
+			fakeTopologyService := mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.
+				On("IsConfigured", mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeTopologyService.
+				On("GetConfig", mock.Anything, mock.Anything).
+				Return(topology.ClusterwideConfigData{"app": map[string]any{"timeout": 5}}, nil)
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newCartridgeConfigReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, configName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplyConfig", mock.Anything, mock.Anything, mock.Anything)
+
+			config := &v1beta1.CartridgeConfig{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configName}, config)
+			Expect(err).NotTo(HaveOccurred(), "config gone")
+			Expect(config.Status.Phase).To(Equal(v1beta1.CartridgeConfigReady))
+		})
+	})
+})
+
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/common"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+//+kubebuilder:rbac:groups=tarantool.io,resources=clusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeschemas,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeschemas/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=tarantool.io,resources=cartridgeschemas/finalizers,verbs=update
+
+func NewCartridgeSchemaReconciler(mgr Manager) *CartridgeSchemaReconciler {
+	k8sConfig := mgr.GetConfig()
+	k8sClient := mgr.GetClient()
+	k8sScheme := mgr.GetScheme()
+	restClient, _ := apiutil.RESTClientForGVK(
+		schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Pod",
+		},
+		false,
+		k8sConfig,
+		serializer.NewCodecFactory(k8sScheme),
+		&http.Client{},
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+	resourcesManager := &implementation.ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: k8sClient,
+			Scheme: k8sScheme,
+		},
+	}
+	eventsRecorder := events.NewRecorder(mgr.GetEventRecorderFor("cartridge-schema-controller"))
+	luaTopology := &topology.CommonCartridgeTopology{
+		Transport: &podexec.PodExec{
+			RestClient:    restClient,
+			RestConfig:    k8sConfig,
+			RuntimeScheme: k8sScheme,
+			CLI:           &cli.TarantoolCTL{},
+		},
+	}
+
+	return &CartridgeSchemaReconciler{
+		SteppedReconciler: &SteppedReconciler[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE]{
+			Client: k8sClient,
+			Controller: &CartridgeSchemaControllerCE{
+				CommonCartridgeSchemaController: &CommonCartridgeSchemaController{
+					CommonController: &CommonController{
+						Client: k8sClient,
+						Schema: k8sScheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           k8sClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       luaTopology,
+						},
+						ResourcesManager:  resourcesManager,
+						EventsRecorder:    eventsRecorder,
+						ClusterwideConfig: luaTopology,
+						LabelsManager:     labelsManager,
+					},
+				},
+			},
+		},
+	}
+}
+
+// CartridgeSchemaReconciler reconciles a CartridgeSchema object.
+type CartridgeSchemaReconciler struct {
+	*SteppedReconciler[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE]
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *CartridgeSchemaReconciler) Reconcile(ctx context.Context, req Request) (Result, error) {
+	return r.Run(
+		&CartridgeSchemaContextCE{
+			CommonContext: &CommonContext{
+				Context: ctx,
+				Request: req,
+				Logger:  logr.FromContextOrDiscard(ctx),
+			},
+		},
+		Info[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE]("Reconcile Schema"),
+		GetRequestedObject[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE](&CartridgeSchema{}),
+		ResetCartridgeSchemaStatus(),
+		SetCartridgeSchemaPhase(CartridgeSchemaWaitingForCluster),
+		GetClusterByLabels[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE](),
+		WaitForClusterBootstrapped[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE](),
+		SetCartridgeSchemaPhase(CartridgeSchemaWaitingForLeader),
+		GetLeader[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE](),
+		SetCartridgeSchemaPhase(CartridgeSchemaApplying),
+		ApplySchema(),
+		SetCartridgeSchemaPhase(CartridgeSchemaReady),
+		Info[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE]("CartridgeSchema ready"),
+	)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CartridgeSchemaReconciler) SetupWithManager(mgr Manager) error {
+	return NewControllerManagedBy(mgr).
+		For(&CartridgeSchema{}).
+		Complete(r)
+}
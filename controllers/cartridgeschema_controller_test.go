@@ -0,0 +1,237 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	customerSchema = `
+spaces:
+  customer:
+    engine: memtx
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+      - {name: name, type: string, is_nullable: true}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: unsigned, is_nullable: false}]}
+`
+	customerWithoutNameSchema = `
+spaces:
+  customer:
+    engine: memtx
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: unsigned, is_nullable: false}]}
+`
+)
+
+func newCartridgeSchemaReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *CartridgeSchemaReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &CartridgeSchemaReconciler{
+		SteppedReconciler: &reconciliation.SteppedReconciler[*CartridgeSchemaContextCE, *CartridgeSchemaControllerCE]{
+			Client: fakeClient,
+			Controller: &CartridgeSchemaControllerCE{
+				CommonCartridgeSchemaController: &reconciliation.CommonCartridgeSchemaController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager:  resourcesManager,
+						LabelsManager:     labelsManager,
+						EventsRecorder:    eventsRecorder,
+						ClusterwideConfig: fakeTopologyService,
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("cartridgeschema_controller unit testing", func() {
+	var (
+		ctx                 = context.Background()
+		namespace           = "default"
+		clusterName         string
+		schemaName          string
+		cartridge           *resources.FakeCartridge
+		fakeTopologyService *mocks.FakeCartridgeTopology
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		schemaName = fmt.Sprintf("schema-%s", utils.RandStringRunes(4))
+
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			WithRouterRole(1, 1).
+			WithRouterStatefulSetsCreated().
+			WithRouterPodsCreated().
+			WithAllPodsRunning().
+			Bootstrapped()
+		cartridge.WithLeader(cartridge.Pods[0].GetName())
+
+		fakeTopologyService = mocks.NewFakeCartridgeTopology()
+		fakeTopologyService.
+			On("IsConfigured", mock.Anything, mock.Anything).
+			Return(true, nil)
+	})
+
+	reconcileSchema := func() (*v1beta1.CartridgeSchema, error) {
+		fakeClient := cartridge.BuildFakeClient()
+		reconciler := newCartridgeSchemaReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+		_, reconcileErr := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, schemaName))
+
+		schema := &v1beta1.CartridgeSchema{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: schemaName}, schema)
+		Expect(err).NotTo(HaveOccurred(), "schema gone")
+
+		return schema, reconcileErr
+	}
+
+	It("must apply valid schema", func() {
+		cartridge.WithCartridgeSchema(schemaName, customerSchema, false)
+
+		fakeTopologyService.
+			On("GetSchema", mock.Anything, mock.Anything).
+			Return("", nil)
+		fakeTopologyService.
+			On("CheckSchema", mock.Anything, mock.Anything, customerSchema).
+			Return(nil)
+		fakeTopologyService.
+			On("ApplySchema", mock.Anything, mock.Anything, customerSchema).
+			Return(nil).
+			Once()
+
+		schema, err := reconcileSchema()
+		Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+		fakeTopologyService.AssertExpectations(GinkgoT())
+		Expect(schema.Status.Phase).To(Equal(v1beta1.CartridgeSchemaReady))
+		Expect(schema.Status.AppliedVersion).NotTo(BeEmpty())
+		Expect(schema.Status.Error).To(BeNil())
+	})
+
+	It("must not apply schema which is already applied", func() {
+		cartridge.WithCartridgeSchema(schemaName, customerSchema, false)
+
+		fakeTopologyService.
+			On("GetSchema", mock.Anything, mock.Anything).
+			Return(customerSchema, nil)
+
+		schema, err := reconcileSchema()
+		Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplySchema", mock.Anything, mock.Anything, mock.Anything)
+		Expect(schema.Status.Phase).To(Equal(v1beta1.CartridgeSchemaReady))
+		Expect(schema.Status.AppliedVersion).NotTo(BeEmpty())
+	})
+
+	It("must report invalid schema", func() {
+		cartridge.WithCartridgeSchema(schemaName, customerSchema, false)
+
+		fakeTopologyService.
+			On("GetSchema", mock.Anything, mock.Anything).
+			Return("", nil)
+		fakeTopologyService.
+			On("CheckSchema", mock.Anything, mock.Anything, customerSchema).
+			Return(topology.NewSchemaValidationError(&topology.LuaError{
+				ClassName: "CheckSchemaError",
+				Err:       "spaces.customer.format[1].type: unknown type",
+			}))
+
+		schema, err := reconcileSchema()
+		Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplySchema", mock.Anything, mock.Anything, mock.Anything)
+		Expect(schema.Status.Phase).To(Equal(v1beta1.CartridgeSchemaInvalid))
+		Expect(schema.Status.Error).To(Equal(&v1beta1.CartridgeSchemaError{
+			ClassName: "CheckSchemaError",
+			Message:   "spaces.customer.format[1].type: unknown type",
+		}))
+	})
+
+	It("must refuse destructive changes unless allowed", func() {
+		cartridge.WithCartridgeSchema(schemaName, customerWithoutNameSchema, false)
+
+		fakeTopologyService.
+			On("GetSchema", mock.Anything, mock.Anything).
+			Return(customerSchema, nil)
+		fakeTopologyService.
+			On("CheckSchema", mock.Anything, mock.Anything, customerWithoutNameSchema).
+			Return(nil)
+
+		schema, err := reconcileSchema()
+		Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "ApplySchema", mock.Anything, mock.Anything, mock.Anything)
+		Expect(schema.Status.Phase).To(Equal(v1beta1.CartridgeSchemaDestructive))
+		Expect(schema.Status.Error.Message).To(ContainSubstring("field customer.name is removed"))
+	})
+
+	It("must apply destructive changes if allowed", func() {
+		cartridge.WithCartridgeSchema(schemaName, customerWithoutNameSchema, true)
+
+		fakeTopologyService.
+			On("GetSchema", mock.Anything, mock.Anything).
+			Return(customerSchema, nil)
+		fakeTopologyService.
+			On("CheckSchema", mock.Anything, mock.Anything, customerWithoutNameSchema).
+			Return(nil)
+		fakeTopologyService.
+			On("ApplySchema", mock.Anything, mock.Anything, customerWithoutNameSchema).
+			Return(nil).
+			Once()
+
+		schema, err := reconcileSchema()
+		Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+
+		fakeTopologyService.AssertExpectations(GinkgoT())
+		Expect(schema.Status.Phase).To(Equal(v1beta1.CartridgeSchemaReady))
+	})
+})
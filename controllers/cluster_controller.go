@@ -9,6 +9,7 @@ import (
 	. "github.com/tarantool/tarantool-operator/internal"
 	. "github.com/tarantool/tarantool-operator/internal/implementation"
 	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/api"
 	"github.com/tarantool/tarantool-operator/pkg/election"
 	"github.com/tarantool/tarantool-operator/pkg/events"
 	"github.com/tarantool/tarantool-operator/pkg/k8s"
@@ -17,14 +18,19 @@ import (
 	"github.com/tarantool/tarantool-operator/pkg/topology"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 //+kubebuilder:rbac:groups=tarantool.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
@@ -40,6 +46,7 @@ import (
 //+kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;create;update;watch;list;patch;delete
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;create;update;watch;list;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;watch;list
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;podmonitors,verbs=get;list;watch;create;update;patch;delete
 
 func NewClusterReconciler(mgr Manager) *ClusterReconciler {
 	k8sConfig := mgr.GetConfig()
@@ -76,6 +83,16 @@ func NewClusterReconciler(mgr Manager) *ClusterReconciler {
 			CLI:           &cli.TarantoolCTL{},
 		},
 	}
+	tarantool3Topology := &topology.CommonTarantool3Topology{
+		Transport: &podexec.PodExec{
+			RestClient:    restClient,
+			RestConfig:    k8sConfig,
+			RuntimeScheme: k8sScheme,
+			CLI: &cli.TT{
+				SocketPath: utils.ConsoleSocket,
+			},
+		},
+	}
 
 	return &ClusterReconciler{
 		LabelsManager: labelsManager,
@@ -90,12 +107,15 @@ func NewClusterReconciler(mgr Manager) *ClusterReconciler {
 							Client:           k8sClient,
 							Recorder:         eventsRecorder,
 							ResourcesManager: resourcesManager,
-							Topology:         luaTopology,
+							Membership:       luaTopology,
 						},
-						ResourcesManager: resourcesManager,
-						EventsRecorder:   eventsRecorder,
-						Topology:         luaTopology,
-						LabelsManager:    labelsManager,
+						ResourcesManager:  resourcesManager,
+						EventsRecorder:    eventsRecorder,
+						Membership:        luaTopology,
+						Sharding:          luaTopology,
+						Failover:          luaTopology,
+						LabelsManager:     labelsManager,
+						DeclarativeConfig: tarantool3Topology,
 					},
 				},
 			},
@@ -108,6 +128,8 @@ type ClusterReconciler struct {
 	*SteppedReconciler[*ClusterContextCE, *ClusterControllerCE]
 
 	LabelsManager k8s.LabelsManager
+	// TopologyEvents is an optional source of reconcile requests produced by watcher.TopologyWatcher
+	TopologyEvents <-chan event.GenericEvent
 }
 
 func (r *ClusterReconciler) Reconcile(ctx context.Context, req Request) (Result, error) {
@@ -126,11 +148,22 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req Request) (Result,
 		ResetClusterStatus(),
 		SetClusterPhase(ClusterSyncingService),
 		SyncClusterWideService(),
+		SyncMetrics(),
 		SetClusterPhase(ClusterWaitingForRoles),
 		WaitForRolesPhases(RoleWaitingForBootstrap, RoleReady),
+		SetClusterPhase(ClusterConfiguring),
+		SyncClusterConfig(),
+		CompleteForFlavor[*ClusterContextCE, *ClusterControllerCE](api.ClusterFlavorTarantool3,
+			BootstrapTarantool3(),
+			SetClusterPhase(ClusterReady),
+		),
+		CompleteForFlavor[*ClusterContextCE, *ClusterControllerCE](api.ClusterFlavorPlain,
+			SetClusterPhase(ClusterReady),
+		),
 		Info[*ClusterContextCE, *ClusterControllerCE]("All roles ready, we are going to bootstrap cluster"),
 		SetClusterPhase(ClusterWaitingForLeader),
 		GetLeader[*ClusterContextCE, *ClusterControllerCE](),
+		LabelLeader(),
 		Bootstrap(BootstrapParams{
 			OnError: ClusterUnableToBootstrap,
 		}),
@@ -143,7 +176,7 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req Request) (Result,
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterReconciler) SetupWithManager(mgr Manager) error {
-	return NewControllerManagedBy(mgr).
+	bldr := NewControllerManagedBy(mgr).
 		For(&Cluster{}).
 		Owns(&v1.Service{}).
 		Watches(
@@ -154,24 +187,45 @@ func (r *ClusterReconciler) SetupWithManager(mgr Manager) error {
 				&Cluster{},
 			),
 		).
-		Watches(&Role{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
-			role, ok := obj.(*Role)
-			if !ok {
-				return []Request{}
-			}
+		// Roles waiting for bootstrap make cluster bootstrap them. Roles are created and deleted after bootstrap too,
+		// cluster is reconciled to sync their metrics Services. Changes of role spec are rendered into
+		// Tarantool 3.x config of cluster.
+		Watches(
+			&Role{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				clusterName, ok := obj.GetLabels()[r.LabelsManager.ClusterName()]
+				if !ok {
+					return []Request{}
+				}
 
-			if labels := role.GetLabels(); role.Status.Phase == RoleWaitingForBootstrap && labels != nil {
 				return []Request{
 					{
 						NamespacedName: types.NamespacedName{
-							Namespace: role.GetNamespace(),
-							Name:      labels[r.LabelsManager.ClusterName()],
+							Namespace: obj.GetNamespace(),
+							Name:      clusterName,
 						},
 					},
 				}
-			}
+			}),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+						return true
+					}
+
+					role, ok := e.ObjectNew.(*Role)
+
+					return ok && role.Status.Phase == RoleWaitingForBootstrap
+				},
+			}),
+		)
+
+	if r.TopologyEvents != nil {
+		bldr = bldr.WatchesRawSource(
+			&source.Channel{Source: r.TopologyEvents},
+			&handler.EnqueueRequestForObject{},
+		)
+	}
 
-			return []Request{}
-		})).
-		Complete(r)
+	return bldr.Complete(r)
 }
@@ -3,6 +3,7 @@ package controllers_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -11,21 +12,75 @@ import (
 	. "github.com/tarantool/tarantool-operator/controllers"
 	. "github.com/tarantool/tarantool-operator/internal"
 	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/api"
 	"github.com/tarantool/tarantool-operator/pkg/election"
 	"github.com/tarantool/tarantool-operator/pkg/events"
 	"github.com/tarantool/tarantool-operator/pkg/k8s"
 	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
 	"github.com/tarantool/tarantool-operator/pkg/topology"
+	pkgutils "github.com/tarantool/tarantool-operator/pkg/utils"
 	"github.com/tarantool/tarantool-operator/test/mocks"
 	"github.com/tarantool/tarantool-operator/test/resources"
 	"github.com/tarantool/tarantool-operator/test/utils"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
+func newClusterReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *ClusterReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &ClusterReconciler{
+		LabelsManager: labelsManager,
+		SteppedReconciler: &reconciliation.SteppedReconciler[*ClusterContextCE, *ClusterControllerCE]{
+			Client: fakeClient,
+			Controller: &ClusterControllerCE{
+				CommonClusterController: &reconciliation.CommonClusterController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager: resourcesManager,
+						LabelsManager:    labelsManager,
+						EventsRecorder:   eventsRecorder,
+						Membership:       fakeTopologyService,
+						Sharding:         fakeTopologyService,
+						Failover:         fakeTopologyService,
+					},
+				},
+			},
+		},
+	}
+}
+
 var _ = Describe("cluster_controller unit testing", func() {
 	var (
 		ctx         = context.Background()
@@ -41,7 +96,7 @@ var _ = Describe("cluster_controller unit testing", func() {
 		Describe("cluster controller should reconcile deletion", func() {
 			It("must accept and process request with cluster which does not exists", func() {
 				fakeClient := fake.NewClientBuilder().Build()
-				fakeTopologyService := new(mocks.FakeCartridgeTopology)
+				fakeTopologyService := mocks.NewFakeCartridgeTopology()
 
 				labelsManager := &k8s.NamespacedLabelsManager{
 					Namespace: "tarantool.io",
@@ -70,12 +125,14 @@ var _ = Describe("cluster_controller unit testing", func() {
 										Client:           fakeClient,
 										Recorder:         eventsRecorder,
 										ResourcesManager: resourcesManager,
-										Topology:         fakeTopologyService,
+										Membership:       fakeTopologyService,
 									},
 									ResourcesManager: resourcesManager,
 									LabelsManager:    labelsManager,
 									EventsRecorder:   eventsRecorder,
-									Topology:         fakeTopologyService,
+									Membership:       fakeTopologyService,
+									Sharding:         fakeTopologyService,
+									Failover:         fakeTopologyService,
 								},
 							},
 						},
@@ -113,7 +170,7 @@ var _ = Describe("cluster_controller unit testing", func() {
 					WithRouterPodsCreated().
 					WithStoragePodsCreated()
 
-				fakeTopologyService = new(mocks.FakeCartridgeTopology)
+				fakeTopologyService = mocks.NewFakeCartridgeTopology()
 
 				fakeTopologyService.
 					On("BootstrapVshard", mock.Anything).
@@ -131,7 +188,7 @@ var _ = Describe("cluster_controller unit testing", func() {
 					Once()
 
 				fakeTopologyService.
-					On("IsCartridgeStarted", mock.Anything, mock.Anything).
+					On("IsStarted", mock.Anything, mock.Anything).
 					Return(true, nil)
 
 				fakeClient := cartridge.BuildFakeClient()
@@ -163,12 +220,14 @@ var _ = Describe("cluster_controller unit testing", func() {
 										Client:           fakeClient,
 										Recorder:         eventsRecorder,
 										ResourcesManager: resourcesManager,
-										Topology:         fakeTopologyService,
+										Membership:       fakeTopologyService,
 									},
 									ResourcesManager: resourcesManager,
 									LabelsManager:    labelsManager,
 									EventsRecorder:   eventsRecorder,
-									Topology:         fakeTopologyService,
+									Membership:       fakeTopologyService,
+									Sharding:         fakeTopologyService,
+									Failover:         fakeTopologyService,
 								},
 							},
 						},
@@ -214,12 +273,14 @@ var _ = Describe("cluster_controller unit testing", func() {
 										Client:           fakeClient,
 										Recorder:         eventsRecorder,
 										ResourcesManager: resourcesManager,
-										Topology:         fakeTopologyService,
+										Membership:       fakeTopologyService,
 									},
 									ResourcesManager: resourcesManager,
 									LabelsManager:    labelsManager,
 									EventsRecorder:   eventsRecorder,
-									Topology:         fakeTopologyService,
+									Membership:       fakeTopologyService,
+									Sharding:         fakeTopologyService,
+									Failover:         fakeTopologyService,
 								},
 							},
 						},
@@ -267,12 +328,14 @@ var _ = Describe("cluster_controller unit testing", func() {
 										Client:           fakeClient,
 										Recorder:         eventsRecorder,
 										ResourcesManager: resourcesManager,
-										Topology:         fakeTopologyService,
+										Membership:       fakeTopologyService,
 									},
 									ResourcesManager: resourcesManager,
 									LabelsManager:    labelsManager,
 									EventsRecorder:   eventsRecorder,
-									Topology:         fakeTopologyService,
+									Membership:       fakeTopologyService,
+									Sharding:         fakeTopologyService,
+									Failover:         fakeTopologyService,
 								},
 							},
 						},
@@ -292,4 +355,440 @@ var _ = Describe("cluster_controller unit testing", func() {
 			})
 		})
 	})
+
+	Context("metrics", func() {
+		var (
+			cartridge     *resources.FakeCartridge
+			fakeClient    client.WithWatch
+			labelsManager = &k8s.NamespacedLabelsManager{
+				Namespace: "tarantool.io",
+			}
+		)
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithStorageRole(1, 1)
+
+			cartridge.Cluster.Spec.Metrics = &v1beta1.ClusterMetrics{
+				Interval: "30s",
+				Labels:   map[string]string{"release": "prometheus"},
+			}
+
+			fakeClient = cartridge.BuildFakeClient()
+		})
+
+		reconcileCluster := func() {
+			reconciler := newClusterReconciler(fakeClient, labelsManager, mocks.NewFakeCartridgeTopology())
+
+			_, _ = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, clusterName))
+		}
+
+		getServiceMonitor := func() (*unstructured.Unstructured, error) {
+			monitor := pkgutils.NewServiceMonitor()
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, monitor)
+
+			return monitor, err
+		}
+
+		It("must create metrics Service per role and ServiceMonitor", func() {
+			reconcileCluster()
+
+			for _, role := range []string{resources.RoleRouter, resources.RoleStorage} {
+				svc := &corev1.Service{}
+				err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: role + "-metrics"}, svc)
+				Expect(err).NotTo(HaveOccurred(), "metrics Service is not created")
+				Expect(svc.Spec.Selector).To(Equal(map[string]string{
+					labelsManager.ClusterName(): clusterName,
+					labelsManager.RoleName():    role,
+				}))
+				Expect(svc.Spec.Ports[0].Port).To(Equal(int32(8081)))
+			}
+
+			monitor, err := getServiceMonitor()
+			Expect(err).NotTo(HaveOccurred(), "ServiceMonitor is not created")
+			Expect(monitor.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+
+			endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+			Expect(endpoints).To(Equal([]interface{}{
+				map[string]interface{}{"port": "metrics", "path": "/metrics", "interval": "30s"},
+			}))
+
+			targetLabels, _, _ := unstructured.NestedStringSlice(monitor.Object, "spec", "podTargetLabels")
+			Expect(targetLabels).To(ConsistOf(
+				labelsManager.ClusterName(),
+				labelsManager.RoleName(),
+				labelsManager.ReplicasetName(),
+			))
+		})
+
+		It("must not update ServiceMonitor because of fields defaulted by API server", func() {
+			reconcileCluster()
+
+			monitor, err := getServiceMonitor()
+			Expect(err).NotTo(HaveOccurred())
+
+			endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+			endpoints[0].(map[string]interface{})["scheme"] = "http"
+			Expect(unstructured.SetNestedSlice(monitor.Object, endpoints, "spec", "endpoints")).To(Succeed())
+			Expect(unstructured.SetNestedField(monitor.Object, "job", "spec", "jobLabel")).To(Succeed())
+			Expect(fakeClient.Update(ctx, monitor)).To(Succeed())
+
+			resourceVersion := monitor.GetResourceVersion()
+
+			reconcileCluster()
+
+			monitor, err = getServiceMonitor()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(monitor.GetResourceVersion()).To(Equal(resourceVersion), "ServiceMonitor is updated")
+		})
+
+		It("must create PodMonitor when only PodMonitor CRD is installed", func() {
+			fakeClient = cartridge.NewFakeClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						if obj.GetObjectKind().GroupVersionKind() == pkgutils.ServiceMonitorGVK {
+							return &meta.NoKindMatchError{GroupKind: pkgutils.ServiceMonitorGVK.GroupKind()}
+						}
+
+						return c.Get(ctx, key, obj, opts...)
+					},
+				}).
+				Build()
+
+			reconcileCluster()
+
+			monitor := pkgutils.NewPodMonitor()
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, monitor)
+			Expect(err).NotTo(HaveOccurred(), "PodMonitor is not created")
+			Expect(monitor.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+
+			endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+			Expect(endpoints).To(Equal([]interface{}{
+				map[string]interface{}{"targetPort": int64(8081), "path": "/metrics", "interval": "30s"},
+			}))
+
+			targetLabels, _, _ := unstructured.NestedStringSlice(monitor.Object, "spec", "podTargetLabels")
+			Expect(targetLabels).To(ConsistOf(
+				labelsManager.ClusterName(),
+				labelsManager.RoleName(),
+				labelsManager.ReplicasetName(),
+			))
+		})
+
+		It("must not create PodMonitor when ServiceMonitor CRD is installed", func() {
+			reconcileCluster()
+
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, pkgutils.NewPodMonitor())
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "PodMonitor is created")
+		})
+
+		It("must delete metrics Services and ServiceMonitor when metrics are disabled", func() {
+			reconcileCluster()
+
+			cluster := &v1beta1.Cluster{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			cluster.Spec.Metrics = nil
+			err = fakeClient.Update(ctx, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			reconcileCluster()
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-metrics"}, &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "metrics Service is not deleted")
+
+			_, err = getServiceMonitor()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "ServiceMonitor is not deleted")
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, &corev1.Service{})
+			Expect(err).NotTo(HaveOccurred(), "cluster-wide Service must be kept")
+		})
+	})
+
+	Context("tarantool 3 flavor", func() {
+		var (
+			cartridge     *resources.FakeCartridge
+			fakeClient    client.WithWatch
+			labelsManager = &k8s.NamespacedLabelsManager{
+				Namespace: "tarantool.io",
+			}
+		)
+
+		BeforeEach(func() {
+			cartridge = resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithStorageRole(1, 2).
+				WithRouterStatefulSetsCreated().
+				WithStorageStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithStoragePodsCreated().
+				WithAllPodsRunning().
+				WithAllRolesInPhase(v1beta1.RoleReady)
+
+			cartridge.Roles[resources.RoleStorage].Spec.VShard.ClusterRoles = []string{v1beta1.VShardStorageRole}
+			cartridge.Cluster.Spec.Flavor = api.ClusterFlavorTarantool3
+			cartridge.Cluster.Spec.Tarantool3 = &v1beta1.ClusterTarantool3{
+				PeerUser: "replicator",
+				Users: []v1beta1.ClusterUser{
+					{
+						Name:  "replicator",
+						Roles: []string{"replication", "sharding"},
+						PasswordSecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "passwords"},
+							Key:                  "replicator",
+						},
+					},
+				},
+				Config: "memtx:\n  memory: 268435456\n",
+			}
+
+			fakeClient = cartridge.BuildFakeClient()
+		})
+
+		reconcileCluster := func(fakeDeclarativeConfig *mocks.FakeDeclarativeConfig) {
+			reconciler := newClusterReconciler(fakeClient, labelsManager, mocks.NewFakeCartridgeTopology())
+			reconciler.Controller.DeclarativeConfig = fakeDeclarativeConfig
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, clusterName))
+			Expect(err).NotTo(HaveOccurred(), "an error during reconcile")
+		}
+
+		It("must render cluster config into ConfigMap and reload it on all instances once", func() {
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(true, nil)
+
+			reconcileCluster(fakeDeclarativeConfig)
+			fakeDeclarativeConfig.AssertNumberOfCalls(GinkgoT(), "ReloadConfig", 3)
+
+			configMap := &corev1.ConfigMap{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName + "-config"}, configMap)
+			Expect(err).NotTo(HaveOccurred(), "ConfigMap is not created")
+
+			config := map[string]interface{}{}
+			err = yaml.Unmarshal([]byte(configMap.Data[pkgutils.ClusterConfigFile]), &config)
+			Expect(err).NotTo(HaveOccurred(), "config is not valid YAML")
+
+			Expect(config).To(HaveKeyWithValue("memtx", HaveKeyWithValue("memory", 268435456)))
+			Expect(config).To(HaveKeyWithValue("replication", HaveKeyWithValue("failover", "manual")))
+			Expect(config).To(HaveKeyWithValue("iproto", HaveKeyWithValue("advertise", And(
+				HaveKeyWithValue("peer", HaveKeyWithValue("login", "replicator")),
+				HaveKeyWithValue("sharding", HaveKeyWithValue("login", "replicator")),
+			))))
+			Expect(config).To(HaveKeyWithValue("credentials", HaveKeyWithValue("users", HaveKeyWithValue("replicator",
+				HaveKeyWithValue("password", "{{ context.TARANTOOL_PASSWORD_REPLICATOR }}"),
+			))))
+			Expect(config).To(HaveKeyWithValue("groups", HaveKeyWithValue(resources.RoleStorage, And(
+				HaveKeyWithValue("sharding", HaveKeyWithValue("roles", ConsistOf("storage"))),
+				HaveKeyWithValue("replicasets", HaveKeyWithValue("storage-0", And(
+					HaveKeyWithValue("leader", "storage-0-0"),
+					HaveKeyWithValue("instances", HaveKey("storage-0-1")),
+				))),
+			))))
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cartridge.Cluster)
+			Expect(err).NotTo(HaveOccurred(), "cluster gone")
+			Expect(cartridge.Cluster.Status.Phase).To(Equal(v1beta1.ClusterReady))
+			Expect(cartridge.Cluster.Status.AppliedConfigHash).To(Equal(pkgutils.MD5([]byte(configMap.Data[pkgutils.ClusterConfigFile]))))
+			Expect(cartridge.Cluster.Status.Bootstrapped).To(BeFalse(), "vshard must not be bootstrapped by Cartridge")
+
+			reconcileCluster(fakeDeclarativeConfig)
+			fakeDeclarativeConfig.AssertNumberOfCalls(GinkgoT(), "ReloadConfig", 3)
+		})
+
+		It("must render Raft params and election mode of roles for raft failover", func() {
+			quorum := intstr.FromString("N / 2 + 1")
+			cartridge.Cluster.Spec.Failover.Mode = api.FailoverModeRaft
+			cartridge.Cluster.Spec.Failover.Raft = &v1beta1.FailoverRaft{
+				ElectionTimeout: &metav1.Duration{Duration: 1500 * time.Millisecond},
+				SynchroQuorum:   &quorum,
+			}
+			Expect(fakeClient.Update(ctx, cartridge.Cluster)).To(Succeed())
+
+			cartridge.Roles[resources.RoleRouter].Spec.ElectionMode = api.ElectionModeVoter
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(true, nil)
+
+			reconcileCluster(fakeDeclarativeConfig)
+
+			configMap := &corev1.ConfigMap{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName + "-config"}, configMap)
+			Expect(err).NotTo(HaveOccurred(), "ConfigMap is not created")
+
+			config := map[string]interface{}{}
+			err = yaml.Unmarshal([]byte(configMap.Data[pkgutils.ClusterConfigFile]), &config)
+			Expect(err).NotTo(HaveOccurred(), "config is not valid YAML")
+
+			Expect(config).To(HaveKeyWithValue("replication", And(
+				HaveKeyWithValue("failover", "election"),
+				HaveKeyWithValue("election_timeout", 1.5),
+				HaveKeyWithValue("synchro_quorum", "N / 2 + 1"),
+			)))
+			Expect(config).To(HaveKeyWithValue("groups", And(
+				HaveKeyWithValue(resources.RoleRouter, HaveKeyWithValue("replication", HaveKeyWithValue("election_mode", "voter"))),
+				HaveKeyWithValue(resources.RoleStorage, HaveKeyWithValue("replication", HaveKeyWithValue("election_mode", "candidate"))),
+			)))
+		})
+
+		It("must render SSL params of iproto when TLS is enabled", func() {
+			cartridge.Cluster.Spec.TLS = &v1beta1.ClusterTLS{
+				SecretName: "tarantool-tls",
+				MountPath:  "/tls",
+				Ciphers:    "HIGH",
+			}
+			Expect(fakeClient.Update(ctx, cartridge.Cluster)).To(Succeed())
+
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(true, nil)
+
+			reconcileCluster(fakeDeclarativeConfig)
+
+			configMap := &corev1.ConfigMap{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName + "-config"}, configMap)
+			Expect(err).NotTo(HaveOccurred(), "ConfigMap is not created")
+
+			config := map[string]interface{}{}
+			err = yaml.Unmarshal([]byte(configMap.Data[pkgutils.ClusterConfigFile]), &config)
+			Expect(err).NotTo(HaveOccurred(), "config is not valid YAML")
+
+			Expect(config).To(HaveKeyWithValue("groups", HaveKeyWithValue(resources.RoleStorage,
+				HaveKeyWithValue("replicasets", HaveKeyWithValue("storage-0",
+					HaveKeyWithValue("instances", HaveKeyWithValue("storage-0-1",
+						HaveKeyWithValue("iproto", HaveKeyWithValue("listen", ConsistOf(
+							HaveKeyWithValue("params", Equal(map[string]interface{}{
+								"transport":     "ssl",
+								"ssl_cert_file": "/tls/tls.crt",
+								"ssl_key_file":  "/tls/tls.key",
+								"ssl_ca_file":   "/tls/ca.crt",
+								"ssl_ciphers":   "HIGH",
+							})),
+						))),
+					)),
+				)),
+			)))
+		})
+
+		It("must bootstrap vshard from router once config is reloaded", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{"vshard-router"}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeDeclarativeConfig.
+				On("BootstrapVShard", mock.Anything, mock.MatchedBy(func(pod *corev1.Pod) bool {
+					return pod.GetName() == "router-0-0"
+				})).
+				Return(nil)
+
+			reconcileCluster(fakeDeclarativeConfig)
+			fakeDeclarativeConfig.AssertNumberOfCalls(GinkgoT(), "BootstrapVShard", 1)
+
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cartridge.Cluster)
+			Expect(err).NotTo(HaveOccurred(), "cluster gone")
+			Expect(cartridge.Cluster.Status.Bootstrapped).To(BeTrue())
+			Expect(cartridge.Cluster.Status.Phase).To(Equal(v1beta1.ClusterReady))
+
+			reconcileCluster(fakeDeclarativeConfig)
+			fakeDeclarativeConfig.AssertNumberOfCalls(GinkgoT(), "BootstrapVShard", 1)
+		})
+
+		It("must wait until vshard is bootstrapped", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{"vshard-router"}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(true, nil)
+			fakeDeclarativeConfig.
+				On("BootstrapVShard", mock.Anything, mock.Anything).
+				Return(fmt.Errorf("replicaset storage-0 is not connected"))
+
+			reconcileCluster(fakeDeclarativeConfig)
+
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cartridge.Cluster)
+			Expect(err).NotTo(HaveOccurred(), "cluster gone")
+			Expect(cartridge.Cluster.Status.Bootstrapped).To(BeFalse())
+			Expect(cartridge.Cluster.Status.Phase).To(Equal(v1beta1.ClusterConfiguring))
+		})
+
+		It("must wait until config file is updated in pods", func() {
+			fakeDeclarativeConfig := mocks.NewFakeDeclarativeConfig()
+			fakeDeclarativeConfig.
+				On("ReloadConfig", mock.Anything, mock.Anything, mock.Anything).
+				Return(false, nil)
+
+			reconcileCluster(fakeDeclarativeConfig)
+
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cartridge.Cluster)
+			Expect(err).NotTo(HaveOccurred(), "cluster gone")
+			Expect(cartridge.Cluster.Status.Phase).To(Equal(v1beta1.ClusterConfiguring))
+			Expect(cartridge.Cluster.Status.AppliedConfigHash).To(BeEmpty())
+		})
+	})
+
+	Context("topology leader label", func() {
+		It("must move topology leader label to the pod of leader", func() {
+			labelsManager := &k8s.NamespacedLabelsManager{
+				Namespace: "tarantool.io",
+			}
+
+			cartridge := resources.NewFakeCartridge(labelsManager).
+				WithNamespace(namespace).
+				WithClusterName(clusterName).
+				WithRouterRole(1, 1).
+				WithStorageRole(1, 2).
+				WithRouterStatefulSetsCreated().
+				WithStorageStatefulSetsCreated().
+				WithRouterPodsCreated().
+				WithStoragePodsCreated().
+				WithAllRolesInPhase(v1beta1.RoleReady).
+				WithAllPodsRunning().
+				Bootstrapped().
+				WithLeader("storage-0-0")
+
+			for _, pod := range cartridge.Pods {
+				if pod.GetName() == "router-0-0" {
+					pod.Labels[labelsManager.TopologyLeader()] = "true"
+				}
+			}
+
+			fakeTopologyService := mocks.NewFakeCartridgeTopology()
+			fakeTopologyService.On("IsConfigured", mock.Anything, mock.Anything).Return(true, nil)
+			fakeTopologyService.On("GetFailoverParams", mock.Anything, mock.Anything).Return(&topology.FailoverParams{}, nil)
+
+			fakeClient := cartridge.BuildFakeClient()
+			reconciler := newClusterReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+			_, err := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, clusterName))
+			Expect(err).NotTo(HaveOccurred())
+
+			for name, leader := range map[string]bool{"storage-0-0": true, "storage-0-1": false, "router-0-0": false} {
+				pod := &corev1.Pod{}
+				err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+				Expect(err).NotTo(HaveOccurred())
+
+				if leader {
+					Expect(pod.GetLabels()).To(HaveKeyWithValue(labelsManager.TopologyLeader(), "true"))
+				} else {
+					Expect(pod.GetLabels()).NotTo(HaveKey(labelsManager.TopologyLeader()), "label is not removed from %s", name)
+				}
+			}
+		})
+	})
 })
@@ -10,6 +10,7 @@ import (
 	. "github.com/tarantool/tarantool-operator/internal"
 	"github.com/tarantool/tarantool-operator/internal/implementation"
 	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/api"
 	"github.com/tarantool/tarantool-operator/pkg/election"
 	"github.com/tarantool/tarantool-operator/pkg/events"
 	"github.com/tarantool/tarantool-operator/pkg/k8s"
@@ -18,15 +19,30 @@ import (
 	"github.com/tarantool/tarantool-operator/pkg/topology"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
 	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 //+kubebuilder:rbac:groups=tarantool.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=tarantool.io,resources=roles/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolrestores,verbs=get;list;watch
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 
 func NewRoleReconciler(mgr Manager) *RoleReconciler {
 	k8sConfig := mgr.GetConfig()
@@ -63,8 +79,19 @@ func NewRoleReconciler(mgr Manager) *RoleReconciler {
 			CLI:           &cli.TarantoolCTL{},
 		},
 	}
+	plainTopology := &topology.CommonPlainTopology{
+		Transport: &podexec.PodExec{
+			RestClient:    restClient,
+			RestConfig:    k8sConfig,
+			RuntimeScheme: k8sScheme,
+			CLI: &cli.Console{
+				SocketPath: utils.ConsoleSocket,
+			},
+		},
+	}
 
 	return &RoleReconciler{
+		LabelsManager: labelsManager,
 		SteppedReconciler: &SteppedReconciler[*RoleContextCE, *RoleControllerCE]{
 			Client: k8sClient,
 			Controller: &RoleControllerCE{
@@ -81,12 +108,19 @@ func NewRoleReconciler(mgr Manager) *RoleReconciler {
 							Client:           k8sClient,
 							Recorder:         eventsRecorder,
 							ResourcesManager: resourcesManager,
-							Topology:         luaTopology,
+							Membership:       luaTopology,
 						},
-						ResourcesManager: resourcesManager,
-						EventsRecorder:   eventsRecorder,
-						Topology:         luaTopology,
-						LabelsManager:    labelsManager,
+						ResourcesManager:   resourcesManager,
+						EventsRecorder:     eventsRecorder,
+						LabelsManager:      labelsManager,
+						Membership:         luaTopology,
+						ReplicasetConfig:   luaTopology,
+						Sharding:           luaTopology,
+						Failover:           luaTopology,
+						Storage:            luaTopology,
+						Election:           luaTopology,
+						ReplicationOptions: luaTopology,
+						Replication:        plainTopology,
 					},
 				},
 			},
@@ -97,6 +131,10 @@ func NewRoleReconciler(mgr Manager) *RoleReconciler {
 // RoleReconciler reconciles a Role object.
 type RoleReconciler struct {
 	*SteppedReconciler[*RoleContextCE, *RoleControllerCE]
+
+	LabelsManager k8s.LabelsManager
+	// TopologyEvents is an optional source of reconcile requests produced by watcher.TopologyWatcher
+	TopologyEvents <-chan event.GenericEvent
 }
 
 func (r *RoleReconciler) Reconcile(ctx context.Context, req Request) (Result, error) {
@@ -115,9 +153,29 @@ func (r *RoleReconciler) Reconcile(ctx context.Context, req Request) (Result, er
 		SetRolePhase(RoleWaitingForCluster),
 		GetClusterByLabels[*RoleContextCE, *RoleControllerCE](),
 
+		SetRolePhase(RoleWaitingForRestore),
+		WaitForRestore[*RoleContextCE, *RoleControllerCE](),
+
 		SetRolePhase(RolePending),
+		SyncCertificate(),
+		ValidateTLSSecret(),
 		CreateStatefulSets(),
 		UpdateStatefulSets(),
+		SyncPodDisruptionBudgets(),
+		SyncServices(),
+		ReportScale(),
+
+		SetRolePhase(RoleExpandingVolumes),
+		ExpandVolumeClaims(),
+		ReportZones(),
+		CompleteForFlavor[*RoleContextCE, *RoleControllerCE](api.ClusterFlavorTarantool3,
+			SetRolePhase(RoleReady),
+		),
+		CompleteForFlavor[*RoleContextCE, *RoleControllerCE](api.ClusterFlavorPlain,
+			SetRolePhase(RoleConfiguringReplication),
+			ConfigureReplication(),
+			SetRolePhase(RoleReady),
+		),
 
 		SetRolePhase(RoleWaitingForLeader),
 		GetLeader[*RoleContextCE, *RoleControllerCE](),
@@ -130,6 +188,9 @@ func (r *RoleReconciler) Reconcile(ctx context.Context, req Request) (Result, er
 			ConfigErrorPhase: RoleConfigError,
 		}),
 
+		SetRolePhase(RoleConfiguringReplication),
+		SetReplicationParams(),
+
 		SetRolePhase(RoleConfiguring),
 		ConfigureVShardRoles(),
 
@@ -139,16 +200,97 @@ func (r *RoleReconciler) Reconcile(ctx context.Context, req Request) (Result, er
 		SetRolePhase(RoleConfiguringWeights),
 		SetVShardWeights(),
 
+		SetRolePhase(RoleScalingDown),
+		ScaleDownReplicasets(),
+
+		SetRolePhase(RoleConfiguringFailover),
+		SetFailoverPriority(),
+		LabelMasters(),
+
+		SetRolePhase(RoleConfiguringElection),
+		ConfigureElection(),
+
 		SetRolePhase(RoleReady),
 		Info[*RoleContextCE, *RoleControllerCE]("Role ready"),
+		CheckStorageUsage(),
 	)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RoleReconciler) SetupWithManager(mgr Manager) error {
-	return NewControllerManagedBy(mgr).
+	bldr := NewControllerManagedBy(mgr).
 		For(&Role{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&v1.Pod{}).
-		Complete(r)
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&v1.Service{}).
+		// Volume claims are created by StatefulSets, they are watched to report resize progress
+		Watches(&v1.PersistentVolumeClaim{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			roleName, ok := obj.GetLabels()[r.LabelsManager.RoleName()]
+			if !ok {
+				return []Request{}
+			}
+
+			return []Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: obj.GetNamespace(),
+						Name:      roleName,
+					},
+				},
+			}
+		})).
+		// Renewed certificates are stored in TLS Secret of cluster, its roles are reconciled to restart instances
+		Watches(
+			&v1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapTLSSecretToRoles),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				_, ok := obj.GetLabels()[r.LabelsManager.ClusterName()]
+
+				return ok
+			})),
+		)
+
+	if r.TopologyEvents != nil {
+		bldr = bldr.WatchesRawSource(
+			&source.Channel{Source: r.TopologyEvents},
+			&handler.EnqueueRequestForObject{},
+		)
+	}
+
+	return bldr.Complete(r)
+}
+
+// mapTLSSecretToRoles enqueues roles of cluster which TLS Secret is changed,
+// cluster is taken from label of Secret, so only labeled Secrets are watched.
+func (r *RoleReconciler) mapTLSSecretToRoles(ctx context.Context, obj client.Object) []reconcile.Request {
+	resourcesManager := r.Controller.GetResourcesManager()
+
+	cluster, err := resourcesManager.GetCluster(ctx, obj.GetNamespace(), obj.GetLabels()[r.LabelsManager.ClusterName()])
+	if err != nil {
+		return []Request{}
+	}
+
+	tls := cluster.GetTLSConfig()
+	if tls == nil || tls.GetSecretName() != obj.GetName() {
+		return []Request{}
+	}
+
+	roles, err := resourcesManager.GetClusterRoles(ctx, cluster)
+	if err != nil {
+		return []Request{}
+	}
+
+	requests := []Request{}
+
+	for _, role := range roles {
+		requests = append(requests, Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: role.GetNamespace(),
+				Name:      role.GetName(),
+			},
+		})
+	}
+
+	return requests
 }
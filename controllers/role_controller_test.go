@@ -0,0 +1,1318 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	pkgutils "github.com/tarantool/tarantool-operator/pkg/utils"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// roleUUIDSpace is used as base of uuids of replicasets in tests.
+var roleUUIDSpace = uuid.MustParse("5b1b2f8e-8c1a-4a43-9d2e-0d3c1b7f5a10")
+
+func newRoleReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *RoleReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &RoleReconciler{
+		LabelsManager: labelsManager,
+		SteppedReconciler: &reconciliation.SteppedReconciler[*RoleContextCE, *RoleControllerCE]{
+			Client: fakeClient,
+			Controller: &RoleControllerCE{
+				ReplicasetsManger: &ReplicasetsManger{
+					ResourcesManager: resourcesManager,
+					UUIDSpace:        roleUUIDSpace,
+				},
+				CommonRoleController: &reconciliation.CommonRoleController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager:   resourcesManager,
+						LabelsManager:      labelsManager,
+						EventsRecorder:     eventsRecorder,
+						Membership:         fakeTopologyService,
+						ReplicasetConfig:   fakeTopologyService,
+						Sharding:           fakeTopologyService,
+						Failover:           fakeTopologyService,
+						Storage:            fakeTopologyService,
+						Election:           fakeTopologyService,
+						ReplicationOptions: fakeTopologyService,
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("role_controller unit testing", func() {
+	var (
+		ctx                 = context.Background()
+		namespace           = "default"
+		clusterName         string
+		cartridge           *resources.FakeCartridge
+		fakeClient          client.WithWatch
+		fakeTopologyService *mocks.FakeCartridgeTopology
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			WithRouterRole(1, 2).
+			WithDataVolumes()
+
+		fakeTopologyService = mocks.NewFakeCartridgeTopology()
+	})
+
+	AfterEach(func() {
+		fakeClient = nil
+	})
+
+	reconcileRole := func() *v1beta1.Role {
+		if fakeClient == nil {
+			fakeClient = cartridge.BuildFakeClient()
+		}
+
+		reconciler := newRoleReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+		_, _ = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, resources.RoleRouter))
+
+		role := &v1beta1.Role{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.RoleRouter}, role)
+		Expect(err).NotTo(HaveOccurred(), "role gone")
+
+		return role
+	}
+
+	getClaim := func(name string) *v1.PersistentVolumeClaim {
+		claim := &v1.PersistentVolumeClaim{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, claim)
+		Expect(err).NotTo(HaveOccurred(), "volume claim not found")
+
+		return claim
+	}
+
+	// createClaims creates claims of router StatefulSet as StatefulSet controller does.
+	createClaims := func(storageClass string, allowExpansion bool) {
+		err := fakeClient.Create(ctx, &storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: storageClass},
+			Provisioner:          "fake.csi.driver",
+			AllowVolumeExpansion: &allowExpansion,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, pod := range []string{"router-0-0", "router-0-1"} {
+			claim := cartridge.NewDataVolumeClaim()
+			claim.SetName("data-" + pod)
+			claim.SetNamespace(namespace)
+			claim.Spec.StorageClassName = &storageClass
+			claim.Status.Capacity = claim.Spec.Resources.Requests
+
+			err = fakeClient.Create(ctx, &claim)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	// resizeRole sets size of data volume claim template of role.
+	resizeRole := func(size resource.Quantity) {
+		role := &v1beta1.Role{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.RoleRouter}, role)
+		Expect(err).NotTo(HaveOccurred())
+
+		template := cartridge.NewDataVolumeClaim()
+		template.Spec.Resources.Requests[v1.ResourceStorage] = size
+		role.Spec.ReplicasetTemplate.VolumeClaimTemplates = []v1.PersistentVolumeClaim{template}
+
+		err = fakeClient.Update(ctx, role)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	getStatefulSet := func() (*appsv1.StatefulSet, error) {
+		sts := &appsv1.StatefulSet{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-0"}, sts)
+
+		return sts, err
+	}
+
+	It("must expand volume claims and recreate StatefulSet", func() {
+		reconcileRole()
+		_, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+
+		createClaims("expandable", true)
+
+		size := resource.MustParse("2Gi")
+		resizeRole(size)
+
+		role := reconcileRole()
+		Expect(getClaim("data-router-0-0").Spec.Resources.Requests.Storage().Cmp(size)).To(Equal(0))
+		Expect(getClaim("data-router-0-1").Spec.Resources.Requests.Storage().Cmp(size)).To(Equal(0))
+		Expect(role.Status.Volumes).To(HaveLen(2))
+		Expect(role.Status.Volumes[0].State).To(Equal(v1beta1.RoleVolumeResizing))
+
+		_, err = getStatefulSet()
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "StatefulSet is not deleted")
+
+		role = reconcileRole()
+		sts, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created again")
+		Expect(sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().Cmp(size)).To(Equal(0))
+		Expect(role.Status.Volumes).To(HaveLen(2))
+
+		for _, name := range []string{"data-router-0-0", "data-router-0-1"} {
+			claim := getClaim(name)
+			claim.Status.Capacity = v1.ResourceList{v1.ResourceStorage: size}
+			err = fakeClient.Status().Update(ctx, claim)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		role = reconcileRole()
+		Expect(role.Status.Volumes).To(BeEmpty())
+	})
+
+	It("must not recreate StatefulSet when volume is not expandable", func() {
+		reconcileRole()
+		createClaims("fixed", false)
+
+		resizeRole(resource.MustParse("2Gi"))
+
+		role := reconcileRole()
+		Expect(role.Status.Volumes).To(HaveLen(2))
+		Expect(role.Status.Volumes[0].State).To(Equal(v1beta1.RoleVolumeNotExpandable))
+		Expect(getClaim("data-router-0-0").Spec.Resources.Requests.Storage().Value()).To(Equal(int64(resources.DefaultStorageLimit)))
+
+		_, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet must not be deleted")
+
+		condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionVolumesNotExpandable)
+		Expect(condition).NotTo(BeNil(), "not expandable claims must be reported in condition")
+		Expect(condition.Message).To(ContainSubstring("data-router-0-0, data-router-0-1"))
+
+		Expect(role.SetVolumesNotExpandable([]string{"data-router-0-0", "data-router-0-1"})).
+			To(BeFalse(), "the same claims must not be reported again")
+		Expect(role.SetVolumesNotExpandable([]string{"data-router-0-0"})).
+			To(BeTrue(), "changed claims must be reported")
+
+		resizeRole(*resource.NewQuantity(resources.DefaultStorageLimit, resource.BinarySI))
+
+		role = reconcileRole()
+		Expect(meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionVolumesNotExpandable)).To(BeNil())
+	})
+
+	It("must create PodDisruptionBudget per replicaset", func() {
+		reconcileRole()
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-0"}, pdb)
+		Expect(err).NotTo(HaveOccurred(), "PodDisruptionBudget is not created")
+		Expect(pdb.Spec.MaxUnavailable.IntValue()).To(Equal(1))
+		Expect(pdb.Spec.Selector.MatchLabels).To(Equal(map[string]string{
+			labelsManager.ReplicasetName(): "router-0",
+		}))
+
+		role := &v1beta1.Role{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.RoleRouter}, role)
+		Expect(err).NotTo(HaveOccurred())
+
+		maxUnavailable := intstr.FromString("50%")
+		role.Spec.MaxUnavailable = &maxUnavailable
+		err = fakeClient.Update(ctx, role)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcileRole()
+
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-0"}, pdb)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pdb.Spec.MaxUnavailable.String()).To(Equal("50%"))
+	})
+
+	It("must spread pods of replicaset according to placement", func() {
+		cartridge.Roles[resources.RoleRouter].Spec.Placement = &v1beta1.RolePlacement{
+			SpreadReplicasAcross: v1beta1.PlacementTopologyZone,
+			Mode:                 v1beta1.PlacementRequired,
+		}
+
+		reconcileRole()
+
+		sts, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+
+		terms := sts.Spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		Expect(terms).To(HaveLen(1))
+		Expect(terms[0].TopologyKey).To(Equal(v1.LabelTopologyZone))
+		Expect(terms[0].LabelSelector.MatchLabels).To(Equal(map[string]string{
+			labelsManager.ReplicasetName(): "router-0",
+		}))
+		Expect(sts.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
+	})
+
+	It("must not create StatefulSets until TLS secret is valid and mount certificates", func() {
+		cartridge.Cluster.Spec.TLS = &v1beta1.ClusterTLS{
+			SecretName: "tarantool-tls",
+			Ciphers:    "ECDHE-RSA-AES256-GCM-SHA384",
+		}
+		cartridge.WithSecret("tarantool-tls", map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+		})
+
+		reconcileRole()
+
+		_, err := getStatefulSet()
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "StatefulSet must not be created without ca.crt")
+
+		secret := &v1.Secret{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "tarantool-tls"}, secret)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret.Data["ca.crt"] = []byte("ca")
+		err = fakeClient.Update(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcileRole()
+
+		sts, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+
+		podSpec := sts.Spec.Template.Spec
+		Expect(podSpec.Volumes).To(ContainElement(HaveField("Secret.SecretName", "tarantool-tls")))
+
+		container := podSpec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(v1.VolumeMount{
+			Name:      pkgutils.TLSVolumeName,
+			MountPath: "/etc/tarantool/tls",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElements(
+			v1.EnvVar{Name: "TARANTOOL_TRANSPORT", Value: "ssl"},
+			v1.EnvVar{Name: "TARANTOOL_SSL_SERVER_CERT_FILE", Value: "/etc/tarantool/tls/tls.crt"},
+			v1.EnvVar{Name: "TARANTOOL_SSL_CLIENT_CA_FILE", Value: "/etc/tarantool/tls/ca.crt"},
+			v1.EnvVar{Name: "TARANTOOL_SSL_CIPHERS", Value: "ECDHE-RSA-AES256-GCM-SHA384"},
+		))
+	})
+
+	It("must request certificate for all instances and delete it when issuer is removed", func() {
+		cartridge.Cluster.Spec.TLS = &v1beta1.ClusterTLS{
+			SecretName: clusterName + "-tls",
+			IssuerRef: &v1beta1.ClusterTLSIssuerRef{
+				Name: "ca-issuer",
+				Kind: "ClusterIssuer",
+			},
+		}
+
+		reconcileRole()
+
+		certificate := pkgutils.NewCertificate()
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, certificate)
+		Expect(err).NotTo(HaveOccurred(), "Certificate is not created")
+		Expect(metav1.IsControlledBy(certificate, cartridge.Cluster)).To(BeTrue(), "Certificate must be owned by cluster")
+
+		secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+		Expect(secretName).To(Equal(clusterName + "-tls"))
+
+		secretLabels, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "secretTemplate", "labels")
+		Expect(secretLabels).To(Equal(map[string]string{labelsManager.ClusterName(): clusterName}))
+
+		_, found, _ := unstructured.NestedString(certificate.Object, "spec", "commonName")
+		Expect(found).To(BeFalse(), "common name is limited to 64 characters and must not be set")
+
+		serviceDomain := fmt.Sprintf("%s.%s.svc.%s", clusterName, namespace, resources.DefaultDomain)
+		dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+		Expect(dnsNames).To(ConsistOf(serviceDomain, "*."+serviceDomain))
+
+		issuerRef, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+		Expect(issuerRef).To(Equal(map[string]string{
+			"name":  "ca-issuer",
+			"kind":  "ClusterIssuer",
+			"group": "cert-manager.io",
+		}))
+
+		cluster := &v1beta1.Cluster{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster.Spec.TLS.IssuerRef = nil
+		err = fakeClient.Update(ctx, cluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcileRole()
+
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, pkgutils.NewCertificate())
+		Expect(apierrors.IsNotFound(err)).To(BeTrue(), "Certificate is not deleted")
+	})
+
+	It("must restart StatefulSets one at a time when certificates are renewed", func() {
+		replicasets := int32(2)
+		cartridge.Roles[resources.RoleRouter].Spec.Replicasets = &replicasets
+		cartridge.Cluster.Spec.TLS = &v1beta1.ClusterTLS{SecretName: "tarantool-tls"}
+		cartridge.WithSecret("tarantool-tls", map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+			"ca.crt":  []byte("ca"),
+		})
+
+		getHash := func(name string) string {
+			sts := &appsv1.StatefulSet{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, sts)
+			Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+
+			return sts.Spec.Template.GetLabels()[labelsManager.ReplicasetPodTemplateHash()]
+		}
+
+		// setRevisions sets revisions of StatefulSet as StatefulSet controller does during rolling update.
+		setRevisions := func(name string, current string, update string) {
+			sts := &appsv1.StatefulSet{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, sts)
+			Expect(err).NotTo(HaveOccurred())
+
+			sts.Status.CurrentRevision = current
+			sts.Status.UpdateRevision = update
+			err = fakeClient.Status().Update(ctx, sts)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		reconcileRole()
+
+		hash := getHash("router-0")
+		Expect(getHash("router-1")).To(Equal(hash))
+
+		reconcileRole()
+		Expect(getHash("router-0")).To(Equal(hash), "pods must not be restarted without renewal")
+
+		secret := &v1.Secret{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "tarantool-tls"}, secret)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret.Data["tls.crt"] = []byte("renewed")
+		err = fakeClient.Update(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcileRole()
+
+		renewedHash := getHash("router-0")
+		Expect(renewedHash).NotTo(Equal(hash), "pod template must be changed to restart pods")
+		Expect(getHash("router-1")).To(Equal(hash), "StatefulSets must be restarted one at a time")
+
+		setRevisions("router-0", "old", "new")
+		reconcileRole()
+		Expect(getHash("router-1")).To(Equal(hash), "StatefulSet must wait for restart of previous one")
+
+		setRevisions("router-0", "new", "new")
+		reconcileRole()
+		Expect(getHash("router-1")).To(Equal(renewedHash), "StatefulSet must be restarted after previous one")
+	})
+
+	It("must mount cluster config and skip Cartridge steps for Tarantool 3.x flavor", func() {
+		cartridge.Cluster.Spec.Flavor = api.ClusterFlavorTarantool3
+		cartridge.Cluster.Spec.Tarantool3 = &v1beta1.ClusterTarantool3{
+			Users: []v1beta1.ClusterUser{
+				{
+					Name: "replicator",
+					PasswordSecretKeyRef: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "passwords"},
+						Key:                  "replicator",
+					},
+				},
+			},
+		}
+
+		role := reconcileRole()
+		Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+
+		sts, err := getStatefulSet()
+		Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+
+		podSpec := sts.Spec.Template.Spec
+		Expect(podSpec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", clusterName+"-config")))
+
+		container := podSpec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(HaveField("MountPath", pkgutils.ClusterConfigMountPath)))
+		Expect(container.Env).To(ContainElements(
+			v1.EnvVar{Name: "TT_CONFIG", Value: "/etc/tarantool/config/config.yaml"},
+			HaveField("Name", "TT_INSTANCE_NAME"),
+			HaveField("ValueFrom.SecretKeyRef.Key", "replicator"),
+		))
+	})
+
+	Context("zones", func() {
+		// createPods creates running pods of router StatefulSet on nodes in listed zones.
+		createPods := func(zones ...string) {
+			for ordinal, zone := range zones {
+				nodeName := fmt.Sprintf("node-%d", ordinal)
+				err := fakeClient.Create(ctx, &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   nodeName,
+						Labels: map[string]string{v1.LabelTopologyZone: zone},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = fakeClient.Create(ctx, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("router-0-%d", ordinal),
+						Namespace: namespace,
+						Labels: map[string]string{
+							labelsManager.ClusterName():    clusterName,
+							labelsManager.RoleName():       resources.RoleRouter,
+							labelsManager.ReplicasetName(): "router-0",
+						},
+					},
+					Spec: v1.PodSpec{
+						NodeName: nodeName,
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		It("must report zones of instances", func() {
+			fakeClient = cartridge.BuildFakeClient()
+			createPods("zone-a", "zone-b")
+
+			role := reconcileRole()
+			Expect(role.Status.Instances).To(Equal([]v1beta1.RoleInstanceStatus{
+				{Pod: "router-0-0", Replicaset: "router-0", Node: "node-0", Zone: "zone-a"},
+				{Pod: "router-0-1", Replicaset: "router-0", Node: "node-1", Zone: "zone-b"},
+			}))
+			Expect(meta.IsStatusConditionFalse(role.Status.Conditions, v1beta1.RoleConditionSingleZone)).To(BeTrue())
+		})
+
+		It("must warn when all replicas are in the same zone", func() {
+			fakeClient = cartridge.BuildFakeClient()
+			createPods("zone-a", "zone-a")
+
+			role := reconcileRole()
+			Expect(meta.IsStatusConditionTrue(role.Status.Conditions, v1beta1.RoleConditionSingleZone)).To(BeTrue())
+			Expect(meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionSingleZone).Message).To(ContainSubstring("router-0"))
+
+			Expect(role.SetSingleZoneReplicasets([]string{"router-0"})).
+				To(BeFalse(), "the same replicasets must not be reported again")
+			Expect(role.SetSingleZoneReplicasets([]string{})).
+				To(BeTrue(), "spread replicasets must change condition")
+		})
+
+		It("must prefer instances of primary zone in failover priority", func() {
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.PrimaryZone = "zone-b"
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+
+			fakeClient = cartridge.BuildFakeClient()
+			createPods("zone-a", "zone-b")
+
+			pods := &v1.PodList{}
+			err := fakeClient.List(ctx, pods, client.InNamespace(namespace))
+			Expect(err).NotTo(HaveOccurred())
+
+			for key := range pods.Items {
+				pod := &pods.Items[key]
+				pod.Status.Phase = v1.PodRunning
+				err = fakeClient.Status().Update(ctx, pod)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			replicasetUUID := (&ReplicasetsManger{UUIDSpace: roleUUIDSpace}).GetReplicasetUUID(cartridge.Roles[resources.RoleRouter], 0)
+			podURI := func(pod string) string {
+				return fmt.Sprintf("%s.%s.%s.svc.%s:%d", pod, clusterName, namespace, resources.DefaultDomain, resources.DefaultListenPort)
+			}
+
+			fakeTopologyService.On("IsStarted", mock.Anything, mock.Anything).Return(true, nil)
+			fakeTopologyService.On("IsConfigured", mock.Anything, mock.Anything).Return(true, nil)
+			fakeTopologyService.On("GetInstanceUUID", mock.Anything, mock.Anything).Return("uuid", nil)
+			fakeTopologyService.On("GetRolesHierarchy", mock.Anything, mock.Anything).Return(map[string][]string{}, nil)
+			fakeTopologyService.On("GetReplicasetRoles", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
+			fakeTopologyService.On("SetWeight", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			fakeTopologyService.On("GetReplicasets", mock.Anything, mock.Anything).Return([]topology.ReplicasetInfo{
+				{
+					UUID: replicasetUUID,
+					Servers: []topology.ServerInfo{
+						{UUID: "uuid-0", URI: podURI("router-0-0")},
+						{UUID: "uuid-1", URI: podURI("router-0-1")},
+					},
+				},
+			}, nil)
+			fakeTopologyService.On("SetFailoverPriority", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			fakeTopologyService.AssertCalled(GinkgoT(), "SetFailoverPriority", mock.Anything, mock.Anything, replicasetUUID, []string{"uuid-1", "uuid-0"})
+		})
+	})
+
+	// mockJoinedTopology makes all instances of role started and joined to listed replicasets.
+	mockJoinedTopology := func(replicasets ...topology.ReplicasetInfo) {
+		fakeTopologyService.On("IsStarted", mock.Anything, mock.Anything).Return(true, nil)
+		fakeTopologyService.On("IsConfigured", mock.Anything, mock.Anything).Return(true, nil)
+		fakeTopologyService.On("GetInstanceUUID", mock.Anything, mock.Anything).Return("uuid", nil)
+		fakeTopologyService.On("GetRolesHierarchy", mock.Anything, mock.Anything).Return(map[string][]string{}, nil)
+		fakeTopologyService.On("GetReplicasetRoles", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
+		fakeTopologyService.On("SetWeight", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		fakeTopologyService.On("GetReplicasets", mock.Anything, mock.Anything).Return(replicasets, nil)
+	}
+
+	// createRunningPods creates the first running pod of each listed router StatefulSet.
+	createRunningPods := func(replicasets ...string) {
+		for _, name := range replicasets {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name + "-0",
+					Namespace: namespace,
+					Labels: map[string]string{
+						labelsManager.ClusterName():       clusterName,
+						labelsManager.RoleName():          resources.RoleRouter,
+						labelsManager.ReplicasetName():    name,
+						labelsManager.ReplicasetOrdinal(): strings.TrimPrefix(name, resources.RoleRouter+"-"),
+					},
+				},
+			}
+			err := fakeClient.Create(ctx, pod)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod.Status.Phase = v1.PodRunning
+			err = fakeClient.Status().Update(ctx, pod)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	Context("scale", func() {
+		It("must report scale and selector of stateless role", func() {
+			role := reconcileRole()
+			Expect(role.Status.Replicasets).To(Equal(int32(1)))
+			Expect(role.Status.Selector).To(Equal(labelsManager.SelectorByRoleName(role).String()))
+		})
+
+		It("must not expose selector of storage role unless autoscaling is allowed", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{v1beta1.VShardStorageRole}
+
+			role := reconcileRole()
+			Expect(role.Status.Replicasets).To(Equal(int32(1)))
+			Expect(role.Status.Selector).To(BeEmpty())
+
+			role.Spec.AllowAutoscaling = true
+			err := fakeClient.Update(ctx, role)
+			Expect(err).NotTo(HaveOccurred())
+
+			role = reconcileRole()
+			Expect(role.Status.Selector).NotTo(BeEmpty())
+		})
+
+		It("must expel and remove replicasets beyond scale", func() {
+			replicasets := int32(2)
+			replicas := int32(1)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.Replicasets = &replicasets
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+
+			mockJoinedTopology()
+			fakeTopologyService.On("ExpelReplicaset", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Replicasets).To(Equal(int32(2)))
+
+			createRunningPods("router-0", "router-1")
+
+			replicasets = 1
+			role.Spec.Replicasets = &replicasets
+			err := fakeClient.Update(ctx, role)
+			Expect(err).NotTo(HaveOccurred())
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+
+			removedUUID := (&ReplicasetsManger{UUIDSpace: roleUUIDSpace}).GetReplicasetUUID(role, 1)
+			fakeTopologyService.AssertCalled(GinkgoT(), "ExpelReplicaset", mock.Anything, mock.Anything, removedUUID)
+			fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "ExpelReplicaset", 1)
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-1"}, &appsv1.StatefulSet{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "StatefulSet of removed replicaset is not deleted")
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-1"}, &policyv1.PodDisruptionBudget{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "PodDisruptionBudget of removed replicaset is not deleted")
+
+			_, err = getStatefulSet()
+			Expect(err).NotTo(HaveOccurred(), "StatefulSet of remaining replicaset must not be deleted")
+
+			role = reconcileRole()
+			Expect(role.Status.Replicasets).To(Equal(int32(1)))
+		})
+
+		It("must keep storage replicasets unless autoscaling is allowed", func() {
+			replicasets := int32(2)
+			replicas := int32(1)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.Replicasets = &replicasets
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{v1beta1.VShardStorageRole}
+
+			mockJoinedTopology()
+			fakeTopologyService.On("SetReplicasetRoles", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			role := reconcileRole()
+			createRunningPods("router-0", "router-1")
+
+			replicasets = 1
+			role.Spec.Replicasets = &replicasets
+			err := fakeClient.Update(ctx, role)
+			Expect(err).NotTo(HaveOccurred())
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionScaleDownBlocked)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Message).To(ContainSubstring("router-1"))
+			Expect(role.SetScaleDownBlocked([]string{"router-1"})).To(BeFalse(), "the same replicasets must not be reported again")
+			Expect(role.SetScaleDownBlocked([]string{"router-1", "router-2"})).To(BeTrue(), "changed replicasets must be reported")
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "SetWeight", mock.Anything, mock.Anything, mock.Anything, int32(0))
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ExpelReplicaset", mock.Anything, mock.Anything, mock.Anything)
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-1"}, &appsv1.StatefulSet{})
+			Expect(err).NotTo(HaveOccurred(), "StatefulSet of storage replicaset must be kept")
+		})
+
+		It("must expel storage replicaset only when buckets are moved away", func() {
+			replicasets := int32(2)
+			replicas := int32(1)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.Replicasets = &replicasets
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{v1beta1.VShardStorageRole}
+			cartridge.Roles[resources.RoleRouter].Spec.AllowAutoscaling = true
+
+			mockJoinedTopology()
+			fakeTopologyService.On("ExpelReplicaset", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			fakeTopologyService.On("SetReplicasetRoles", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			bucketsCount := fakeTopologyService.On("GetBucketsCount", mock.Anything, mock.Anything).Return(int64(100), nil)
+
+			role := reconcileRole()
+			createRunningPods("router-0", "router-1")
+
+			replicasets = 1
+			role.Spec.Replicasets = &replicasets
+			err := fakeClient.Update(ctx, role)
+			Expect(err).NotTo(HaveOccurred())
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleScalingDown))
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ExpelReplicaset", mock.Anything, mock.Anything, mock.Anything)
+
+			bucketsCount.Return(int64(0), nil)
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "ExpelReplicaset", 1)
+		})
+	})
+
+	Context("storage policy", func() {
+		BeforeEach(func() {
+			replicas := int32(1)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+
+			mockJoinedTopology()
+
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+		})
+
+		It("must report usage within thresholds", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.StoragePolicy = &v1beta1.RoleStoragePolicy{}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, mock.Anything).Return(&topology.StorageUsage{
+				MemtxUsed:  50,
+				MemtxLimit: 100,
+			}, nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			Expect(meta.IsStatusConditionFalse(role.Status.Conditions, v1beta1.RoleConditionStorageUsageHigh)).To(BeTrue())
+			Expect(role.GetReplicasets()).To(Equal(int32(1)))
+		})
+
+		It("must warn when vinyl usage exceeds threshold", func() {
+			limit := resource.MustParse("1Gi")
+			cartridge.Roles[resources.RoleRouter].Spec.StoragePolicy = &v1beta1.RoleStoragePolicy{
+				VinylDiskLimit: &limit,
+				MaxReplicasets: 3,
+			}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, mock.Anything).Return(&topology.StorageUsage{
+				MemtxLimit: 100,
+				VinylDisk:  limit.Value() * 9 / 10,
+			}, nil)
+
+			role := reconcileRole()
+			condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionStorageUsageHigh)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("router-0-0 (vinyl 89%)"))
+			Expect(role.GetReplicasets()).To(Equal(int32(1)), "Warn action must not add replicasets")
+		})
+
+		It("must add replicasets up to maximum with cooldown", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.StoragePolicy = &v1beta1.RoleStoragePolicy{
+				Action:         v1beta1.StoragePolicyScaleOut,
+				MaxReplicasets: 2,
+			}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, mock.Anything).Return(&topology.StorageUsage{
+				MemtxUsed:  90,
+				MemtxLimit: 100,
+			}, nil)
+
+			role := reconcileRole()
+			Expect(role.GetReplicasets()).To(Equal(int32(2)))
+			Expect(role.Status.LastScaleOutTime).NotTo(BeNil())
+			Expect(meta.IsStatusConditionTrue(role.Status.Conditions, v1beta1.RoleConditionStorageUsageHigh)).To(BeTrue())
+
+			// New replicaset joins, usage is still high but cooldown and maximum are not passed
+			createRunningPods("router-1")
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			Expect(role.GetReplicasets()).To(Equal(int32(2)))
+			Expect(role.Status.LastScaleOutTime).NotTo(BeNil())
+			Expect(role.Status.LastScaleOutReplicasets).To(Equal(int32(2)))
+
+			condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionScaleOutBlocked)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(api.ScaleOutMaxReached))
+		})
+
+		It("must block scale out without max replicasets", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.StoragePolicy = &v1beta1.RoleStoragePolicy{
+				Action: v1beta1.StoragePolicyScaleOut,
+			}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, mock.Anything).Return(&topology.StorageUsage{
+				MemtxUsed:  90,
+				MemtxLimit: 100,
+			}, nil)
+
+			role := reconcileRole()
+			Expect(role.GetReplicasets()).To(Equal(int32(1)))
+			Expect(role.Status.LastScaleOutTime).To(BeNil())
+
+			condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionScaleOutBlocked)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(api.ScaleOutMaxNotSet))
+			Expect(role.SetScaleOutBlocked(api.ScaleOutMaxNotSet, condition.Message)).To(BeFalse(), "the same reason must not be reported again")
+			Expect(role.SetScaleOutBlocked(api.ScaleOutMaxReached, condition.Message)).To(BeTrue(), "changed reason must be reported")
+		})
+
+		It("must skip instances which do not report usage", func() {
+			replicasets := int32(2)
+			cartridge.Roles[resources.RoleRouter].Spec.Replicasets = &replicasets
+			cartridge.Roles[resources.RoleRouter].Spec.StoragePolicy = &v1beta1.RoleStoragePolicy{}
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+			createRunningPods("router-1")
+
+			isPod := func(name string) interface{} {
+				return mock.MatchedBy(func(pod *v1.Pod) bool {
+					return pod.GetName() == name
+				})
+			}
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, isPod("router-0-0")).Return((*topology.StorageUsage)(nil), errors.New("timeout"))
+			fakeTopologyService.On("GetStorageUsage", mock.Anything, isPod("router-1-0")).Return(&topology.StorageUsage{
+				MemtxUsed:  90,
+				MemtxLimit: 100,
+			}, nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+
+			condition := meta.FindStatusCondition(role.Status.Conditions, v1beta1.RoleConditionStorageUsageHigh)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("router-1-0"))
+		})
+	})
+
+	Context("raft election", func() {
+		BeforeEach(func() {
+			replicas := int32(1)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+
+			quorum := intstr.FromInt(1)
+			cartridge.Cluster.Spec.Failover.Mode = api.FailoverModeRaft
+			cartridge.Cluster.Spec.Failover.Raft = &v1beta1.FailoverRaft{
+				ElectionTimeout: &metav1.Duration{Duration: 2 * time.Second},
+				SynchroQuorum:   &quorum,
+			}
+
+			mockJoinedTopology()
+		})
+
+		It("must apply election params and report term and leader of replicasets", func() {
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+
+			fakeTopologyService.On("ConfigureElection", mock.Anything, mock.Anything, &topology.ElectionParams{
+				Mode:          api.ElectionModeCandidate,
+				Timeout:       2,
+				SynchroQuorum: 1,
+			}).Return(&topology.ElectionState{State: "leader", Term: 3}, nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			Expect(role.Status.Elections).To(Equal([]v1beta1.RoleElectionStatus{
+				{Replicaset: "router-0", Term: 3, Leader: "router-0-0"},
+			}))
+		})
+
+		It("must not configure election when replicasets have no candidates", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.ElectionMode = api.ElectionModeVoter
+
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleConfiguringElection))
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ConfigureElection", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("must not configure election when synchro quorum exceeds replicas", func() {
+			quorum := intstr.FromInt(2)
+			cartridge.Cluster.Spec.Failover.Raft.SynchroQuorum = &quorum
+
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleConfiguringElection))
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "ConfigureElection", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("replication params", func() {
+		BeforeEach(func() {
+			replicas := int32(1)
+			connectQuorum := int32(0)
+			quorum := intstr.FromString("N / 2 + 1")
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+			cartridge.Roles[resources.RoleRouter].Spec.Replication = &v1beta1.RoleReplication{
+				SynchroQuorum:  &quorum,
+				SynchroTimeout: &metav1.Duration{Duration: 5 * time.Second},
+				ConnectQuorum:  &connectQuorum,
+			}
+
+			mockJoinedTopology()
+
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+		})
+
+		It("must apply replication params and recalculate synchro quorum when replicas are changed", func() {
+			connectQuorum := int32(0)
+			fakeTopologyService.On("SetReplicationParams", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			fakeTopologyService.AssertCalled(GinkgoT(), "SetReplicationParams", mock.Anything, mock.Anything, &topology.ReplicationParams{
+				SynchroQuorum:  1,
+				SynchroTimeout: 5,
+				ConnectQuorum:  &connectQuorum,
+			})
+
+			replicas := int32(3)
+			role.Spec.ReplicasetTemplate.Replicas = &replicas
+			Expect(fakeClient.Update(ctx, role)).To(Succeed())
+
+			// StatefulSet controller creates pods of new replicas
+			for _, name := range []string{"router-0-1", "router-0-2"} {
+				pod := &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+						Labels: map[string]string{
+							labelsManager.ClusterName():       clusterName,
+							labelsManager.RoleName():          resources.RoleRouter,
+							labelsManager.ReplicasetName():    "router-0",
+							labelsManager.ReplicasetOrdinal(): "0",
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+				pod.Status.Phase = v1.PodRunning
+				Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+			}
+
+			role = reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			fakeTopologyService.AssertCalled(GinkgoT(), "SetReplicationParams", mock.Anything, mock.Anything, &topology.ReplicationParams{
+				SynchroQuorum:  2,
+				SynchroTimeout: 5,
+				ConnectQuorum:  &connectQuorum,
+			})
+		})
+
+		It("must not apply replication params with invalid synchro quorum formula", func() {
+			quorum := intstr.FromString("N / 2 +")
+			cartridge.Roles[resources.RoleRouter].Spec.Replication.SynchroQuorum = &quorum
+			Expect(fakeClient.Update(ctx, cartridge.Roles[resources.RoleRouter])).To(Succeed())
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleConfiguringReplication))
+			fakeTopologyService.AssertNotCalled(GinkgoT(), "SetReplicationParams", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	Context("client services", func() {
+		getService := func(name string) (*v1.Service, error) {
+			svc := &v1.Service{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, svc)
+
+			return svc, err
+		}
+
+		It("must create single client Service for stateless role", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.Service = &v1beta1.RoleService{
+				Type: v1.ServiceTypeLoadBalancer,
+			}
+
+			reconcileRole()
+
+			svc, err := getService(resources.RoleRouter)
+			Expect(err).NotTo(HaveOccurred(), "client Service is not created")
+			Expect(svc.Spec.Type).To(Equal(v1.ServiceTypeLoadBalancer))
+			Expect(svc.Spec.Selector).To(Equal(map[string]string{
+				labelsManager.ClusterName(): clusterName,
+				labelsManager.RoleName():    resources.RoleRouter,
+			}))
+
+			_, err = getService(resources.RoleRouter + "-rw")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "-rw Service must not be created for stateless role")
+		})
+
+		It("must create -rw and -ro Services for storage role and delete them when disabled", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{v1beta1.VShardStorageRole}
+			cartridge.Roles[resources.RoleRouter].Spec.Service = &v1beta1.RoleService{}
+
+			role := reconcileRole()
+
+			rw, err := getService(resources.RoleRouter + "-rw")
+			Expect(err).NotTo(HaveOccurred(), "-rw Service is not created")
+			Expect(rw.Spec.Selector).To(HaveKeyWithValue(labelsManager.ReplicasetMaster(), "true"))
+
+			ro, err := getService(resources.RoleRouter + "-ro")
+			Expect(err).NotTo(HaveOccurred(), "-ro Service is not created")
+			Expect(ro.Spec.Selector).To(HaveKeyWithValue(labelsManager.ReplicasetMaster(), "false"))
+
+			role.Spec.Service = nil
+			err = fakeClient.Update(ctx, role)
+			Expect(err).NotTo(HaveOccurred())
+
+			reconcileRole()
+
+			for _, name := range []string{resources.RoleRouter, resources.RoleRouter + "-rw", resources.RoleRouter + "-ro"} {
+				_, err = getService(name)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue(), "Service %s is not deleted", name)
+			}
+		})
+
+		It("must remove annotations deleted from spec and keep annotations set by others", func() {
+			cartridge.Roles[resources.RoleRouter].Spec.Service = &v1beta1.RoleService{
+				Annotations: map[string]string{
+					"lb.example.com/internal": "true",
+					"lb.example.com/timeout":  "60",
+				},
+			}
+
+			role := reconcileRole()
+
+			svc, err := getService(resources.RoleRouter)
+			Expect(err).NotTo(HaveOccurred(), "client Service is not created")
+			Expect(svc.GetAnnotations()).To(HaveKeyWithValue("lb.example.com/timeout", "60"))
+
+			svc.SetAnnotations(pkgutils.MergeMaps(svc.GetAnnotations(), map[string]string{"other.io/owner": "team"}))
+			Expect(fakeClient.Update(ctx, svc)).To(Succeed())
+
+			delete(role.Spec.Service.Annotations, "lb.example.com/timeout")
+			Expect(fakeClient.Update(ctx, role)).To(Succeed())
+
+			reconcileRole()
+
+			svc, err = getService(resources.RoleRouter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(svc.GetAnnotations()).NotTo(HaveKey("lb.example.com/timeout"), "removed annotation is kept")
+			Expect(svc.GetAnnotations()).To(HaveKeyWithValue("lb.example.com/internal", "true"))
+			Expect(svc.GetAnnotations()).To(HaveKeyWithValue("other.io/owner", "team"), "annotation of others is removed")
+		})
+
+		It("must label pods of replicaset masters", func() {
+			replicas := int32(2)
+			cartridge.Bootstrapped().WithLeader("router-0-0")
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+			cartridge.Roles[resources.RoleRouter].Spec.VShard.ClusterRoles = []string{}
+
+			replicasetUUID := (&ReplicasetsManger{UUIDSpace: roleUUIDSpace}).GetReplicasetUUID(cartridge.Roles[resources.RoleRouter], 0)
+			mockJoinedTopology(topology.ReplicasetInfo{
+				UUID:      replicasetUUID,
+				MasterURI: fmt.Sprintf("router-0-1.%s.%s.svc.%s:%d", clusterName, namespace, resources.DefaultDomain, resources.DefaultListenPort),
+			})
+
+			fakeClient = cartridge.BuildFakeClient()
+			createRunningPods("router-0")
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "router-0-1",
+					Namespace: namespace,
+					Labels: map[string]string{
+						labelsManager.ClusterName():    clusterName,
+						labelsManager.RoleName():       resources.RoleRouter,
+						labelsManager.ReplicasetName(): "router-0",
+					},
+				},
+				Status: v1.PodStatus{Phase: v1.PodRunning},
+			}
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			role := reconcileRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+
+			for name, master := range map[string]string{"router-0-0": "false", "router-0-1": "true"} {
+				err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.GetLabels()).To(HaveKeyWithValue(labelsManager.ReplicasetMaster(), master))
+			}
+		})
+	})
+
+	Context("plain replication", func() {
+		var fakeReplication *mocks.FakeReplication
+
+		BeforeEach(func() {
+			cartridge.Cluster.Spec.Flavor = api.ClusterFlavorPlain
+			fakeReplication = mocks.NewFakeReplication()
+		})
+
+		reconcilePlainRole := func() *v1beta1.Role {
+			if fakeClient == nil {
+				fakeClient = cartridge.BuildFakeClient()
+			}
+
+			reconciler := newRoleReconciler(fakeClient, labelsManager, fakeTopologyService)
+			reconciler.Controller.Replication = fakeReplication
+
+			_, _ = reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, resources.RoleRouter))
+
+			role := &v1beta1.Role{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.RoleRouter}, role)
+			Expect(err).NotTo(HaveOccurred(), "role gone")
+
+			return role
+		}
+
+		createReplicasetPods := func(names ...string) {
+			for _, name := range names {
+				pod := &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+						Labels: map[string]string{
+							labelsManager.ClusterName():       clusterName,
+							labelsManager.RoleName():          resources.RoleRouter,
+							labelsManager.ReplicasetName():    "router-0",
+							labelsManager.ReplicasetOrdinal(): "0",
+						},
+					},
+				}
+				Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+				pod.Status.Phase = v1.PodRunning
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+				Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+			}
+		}
+
+		uri := func(pod string) string {
+			return fmt.Sprintf("%s.%s.%s.svc.%s:%d", pod, clusterName, namespace, resources.DefaultDomain, resources.DefaultListenPort)
+		}
+
+		podNamed := func(name string) interface{} {
+			return mock.MatchedBy(func(pod *v1.Pod) bool {
+				return pod.GetName() == name
+			})
+		}
+
+		masterLabel := func(name string) string {
+			pod := &v1.Pod{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+			Expect(err).NotTo(HaveOccurred())
+
+			return pod.GetLabels()[labelsManager.ReplicasetMaster()]
+		}
+
+		It("must replicate all peers of StatefulSet, mount console socket and make the first pod master", func() {
+			role := reconcilePlainRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleConfiguringReplication), "role must wait for pods")
+
+			sts, err := getStatefulSet()
+			Expect(err).NotTo(HaveOccurred(), "StatefulSet is not created")
+			Expect(sts.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+				v1.EnvVar{Name: "TARANTOOL_CONSOLE_SOCKET", Value: pkgutils.ConsoleSocket},
+			))
+
+			createReplicasetPods("router-0-0", "router-0-1")
+
+			peers := []string{uri("router-0-0"), uri("router-0-1")}
+			fakeReplication.On("GetVClock", mock.Anything, mock.Anything).Return(topology.VClock{1: 1}, nil)
+			fakeReplication.On("ConfigureReplication", mock.Anything, podNamed("router-0-1"), peers, true).Return(true, nil).Once()
+			fakeReplication.On("ConfigureReplication", mock.Anything, podNamed("router-0-0"), peers, false).Return(true, nil).Once()
+
+			role = reconcilePlainRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleReady))
+			fakeReplication.AssertExpectations(GinkgoT())
+
+			Expect(masterLabel("router-0-0")).To(Equal("true"))
+			Expect(masterLabel("router-0-1")).To(Equal("false"))
+		})
+
+		// stopMaster makes pod of master not running, as it is while pod is recreated.
+		stopMaster := func(name string) {
+			pod := &v1.Pod{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod.Status.Phase = v1.PodPending
+			Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+		}
+
+		It("must switch master when pod of master is not running and unreachable", func() {
+			reconcilePlainRole()
+			createReplicasetPods("router-0-0", "router-0-1")
+
+			fakeReplication.On("ConfigureReplication", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			fakeReplication.On("GetVClock", mock.Anything, mock.Anything).Return(topology.VClock{1: 1}, nil).Twice()
+			reconcilePlainRole()
+
+			stopMaster("router-0-0")
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-0")).Return(topology.VClock(nil), errors.New("unreachable"))
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-1")).Return(topology.VClock{1: 1}, nil)
+
+			role := reconcilePlainRole()
+			Expect(role.Status.Phase).To(Equal(v1beta1.RoleConfiguringReplication), "role must wait for replica to restart")
+			fakeReplication.AssertCalled(GinkgoT(), "ConfigureReplication", mock.Anything, podNamed("router-0-1"), mock.Anything, false)
+
+			Expect(masterLabel("router-0-1")).To(Equal("true"))
+		})
+
+		It("must not switch master while old master is reachable", func() {
+			reconcilePlainRole()
+			createReplicasetPods("router-0-0", "router-0-1")
+
+			fakeReplication.On("ConfigureReplication", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			fakeReplication.On("GetVClock", mock.Anything, mock.Anything).Return(topology.VClock{1: 1}, nil)
+			reconcilePlainRole()
+
+			stopMaster("router-0-0")
+
+			reconcilePlainRole()
+			fakeReplication.AssertNotCalled(GinkgoT(), "ConfigureReplication", mock.Anything, podNamed("router-0-1"), mock.Anything, false)
+
+			Expect(masterLabel("router-0-0")).To(Equal("true"))
+			Expect(masterLabel("router-0-1")).To(Equal("false"))
+		})
+
+		It("must promote only ready replica which has applied all changes", func() {
+			replicas := int32(4)
+			cartridge.Roles[resources.RoleRouter].Spec.ReplicasetTemplate.Replicas = &replicas
+
+			reconcilePlainRole()
+			createReplicasetPods("router-0-0", "router-0-1", "router-0-2", "router-0-3")
+
+			fakeReplication.On("ConfigureReplication", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			fakeReplication.On("GetVClock", mock.Anything, mock.Anything).Return(topology.VClock{1: 1}, nil).Times(4)
+			reconcilePlainRole()
+			Expect(masterLabel("router-0-0")).To(Equal("true"))
+
+			stopMaster("router-0-0")
+
+			pod := &v1.Pod{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "router-0-3"}, pod)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod.Status.Conditions = nil
+			Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-0")).Return(topology.VClock(nil), errors.New("unreachable"))
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-1")).Return(topology.VClock{1: 5, 2: 1}, nil)
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-2")).Return(topology.VClock{1: 7, 2: 1}, nil)
+			fakeReplication.On("GetVClock", mock.Anything, podNamed("router-0-3")).Return(topology.VClock{1: 9, 2: 1}, nil)
+
+			reconcilePlainRole()
+			Expect(masterLabel("router-0-3")).To(Equal("false"), "replica which is not ready must not be promoted")
+
+			Expect(masterLabel("router-0-2")).To(Equal("true"), "replica with the latest changes must be promoted")
+			Expect(masterLabel("router-0-1")).To(Equal("false"))
+		})
+	})
+})
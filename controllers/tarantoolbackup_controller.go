@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/common"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+//+kubebuilder:rbac:groups=tarantool.io,resources=clusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolbackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolbackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolbackups/finalizers,verbs=update
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create;update;watch;list;patch;delete
+
+func NewTarantoolBackupReconciler(mgr Manager) *TarantoolBackupReconciler {
+	k8sConfig := mgr.GetConfig()
+	k8sClient := mgr.GetClient()
+	k8sScheme := mgr.GetScheme()
+	restClient, _ := apiutil.RESTClientForGVK(
+		schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Pod",
+		},
+		false,
+		k8sConfig,
+		serializer.NewCodecFactory(k8sScheme),
+		&http.Client{},
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+	resourcesManager := &implementation.ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: k8sClient,
+			Scheme: k8sScheme,
+		},
+	}
+	eventsRecorder := events.NewRecorder(mgr.GetEventRecorderFor("backup-controller"))
+	luaTopology := &topology.CommonCartridgeTopology{
+		Transport: &podexec.PodExec{
+			RestClient:    restClient,
+			RestConfig:    k8sConfig,
+			RuntimeScheme: k8sScheme,
+			CLI:           &cli.TarantoolCTL{},
+		},
+	}
+
+	return &TarantoolBackupReconciler{
+		SteppedReconciler: &SteppedReconciler[*BackupContextCE, *BackupControllerCE]{
+			Client: k8sClient,
+			Controller: &BackupControllerCE{
+				CommonBackupController: &CommonBackupController{
+					CommonController: &CommonController{
+						Client: k8sClient,
+						Schema: k8sScheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           k8sClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       luaTopology,
+						},
+						ResourcesManager:  resourcesManager,
+						EventsRecorder:    eventsRecorder,
+						Membership:        luaTopology,
+						ClusterwideConfig: luaTopology,
+						Backup:            luaTopology,
+						LabelsManager:     labelsManager,
+					},
+				},
+				BackupManager: &implementation.BackupManager{
+					ResourcesManager: resourcesManager,
+				},
+			},
+		},
+	}
+}
+
+// TarantoolBackupReconciler reconciles a TarantoolBackup object.
+type TarantoolBackupReconciler struct {
+	*SteppedReconciler[*BackupContextCE, *BackupControllerCE]
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *TarantoolBackupReconciler) Reconcile(ctx context.Context, req Request) (Result, error) {
+	return r.Run(
+		&BackupContextCE{
+			CommonContext: &CommonContext{
+				Context: ctx,
+				Request: req,
+				Logger:  logr.FromContextOrDiscard(ctx),
+			},
+		},
+		Info[*BackupContextCE, *BackupControllerCE]("Reconcile backup"),
+		GetRequestedObject[*BackupContextCE, *BackupControllerCE](&TarantoolBackup{}),
+		SkipFinishedBackup(),
+		SetBackupPhase(TarantoolBackupWaitingForCluster),
+		GetClusterByLabels[*BackupContextCE, *BackupControllerCE](),
+		CheckBackupFlavor(),
+		WaitForClusterBootstrapped[*BackupContextCE, *BackupControllerCE](),
+		SetBackupPhase(TarantoolBackupWaitingForLeader),
+		GetLeader[*BackupContextCE, *BackupControllerCE](),
+		SetBackupPhase(TarantoolBackupSnapshotting),
+		MakeSnapshots(),
+		SetBackupPhase(TarantoolBackupUploading),
+		SnapshotBackupVolumes(),
+		UploadBackupFiles(),
+		FinishBackup(),
+		SetBackupPhase(TarantoolBackupCompleted),
+		Info[*BackupContextCE, *BackupControllerCE]("Backup completed"),
+	)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TarantoolBackupReconciler) SetupWithManager(mgr Manager) error {
+	return NewControllerManagedBy(mgr).
+		For(&TarantoolBackup{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,349 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	pkgutils "github.com/tarantool/tarantool-operator/pkg/utils"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newTarantoolBackupReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *TarantoolBackupReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &TarantoolBackupReconciler{
+		SteppedReconciler: &reconciliation.SteppedReconciler[*BackupContextCE, *BackupControllerCE]{
+			Client: fakeClient,
+			Controller: &BackupControllerCE{
+				CommonBackupController: &reconciliation.CommonBackupController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager:  resourcesManager,
+						LabelsManager:     labelsManager,
+						EventsRecorder:    eventsRecorder,
+						Membership:        fakeTopologyService,
+						ClusterwideConfig: fakeTopologyService,
+						Backup:            fakeTopologyService,
+					},
+				},
+				BackupManager: &BackupManager{
+					ResourcesManager: resourcesManager,
+				},
+			},
+		},
+	}
+}
+
+func setJobCondition(ctx context.Context, fakeClient client.Client, namespace, name string, conditionType batchv1.JobConditionType) {
+	job := &batchv1.Job{}
+	err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, job)
+	Expect(err).NotTo(HaveOccurred(), "job not found")
+
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+		Type:   conditionType,
+		Status: v1.ConditionTrue,
+	})
+	err = fakeClient.Status().Update(ctx, job)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+var _ = Describe("tarantoolbackup_controller unit testing", func() {
+	var (
+		ctx                 = context.Background()
+		namespace           = "default"
+		clusterName         string
+		backupName          string
+		cartridge           *resources.FakeCartridge
+		fakeTopologyService *mocks.FakeCartridgeTopology
+		fakeClient          client.WithWatch
+		replicasets         []topology.ReplicasetInfo
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	pvcStorage := v1beta1.BackupStorage{
+		PVC: &v1beta1.BackupPVCStorage{
+			ClaimName: "backups",
+		},
+	}
+
+	podURI := func(pod string) string {
+		return fmt.Sprintf("%s.%s.%s.svc.%s:%d", pod, clusterName, namespace, resources.DefaultDomain, resources.DefaultListenPort)
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		backupName = fmt.Sprintf("backup-%s", utils.RandStringRunes(4))
+
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			WithRouterRole(1, 2).
+			WithRouterStatefulSetsCreated().
+			WithRouterPodsCreated().
+			WithAllPodsRunning().
+			WithDataVolumes().
+			Bootstrapped()
+		cartridge.WithLeader(cartridge.Pods[0].GetName())
+
+		replicasets = []topology.ReplicasetInfo{
+			{
+				UUID:      "rs-uuid",
+				Alias:     "router-0",
+				Roles:     []string{"vshard-router"},
+				MasterURI: podURI("router-0-0"),
+				Servers: []topology.ServerInfo{
+					{UUID: "uuid-0", URI: podURI("router-0-0")},
+					{UUID: "uuid-1", URI: podURI("router-0-1")},
+				},
+			},
+		}
+
+		fakeTopologyService = mocks.NewFakeCartridgeTopology()
+		fakeTopologyService.
+			On("IsConfigured", mock.Anything, mock.Anything).
+			Return(true, nil)
+		fakeTopologyService.
+			On("GetReplicasets", mock.Anything, mock.Anything).
+			Return(replicasets, nil)
+		fakeTopologyService.
+			On("GetConfig", mock.Anything, mock.Anything).
+			Return(topology.ClusterwideConfigData{"custom": "value"}, nil)
+		fakeTopologyService.
+			On("StartBackup", mock.Anything, mock.Anything).
+			Return(&topology.BackupFiles{
+				Workdir: "/var/lib/tarantool/instance",
+				Files:   []string{"/var/lib/tarantool/instance/00000000000000000010.snap"},
+			}, nil)
+		fakeTopologyService.
+			On("StopBackup", mock.Anything, mock.Anything).
+			Return(nil)
+	})
+
+	reconcileBackup := func() (*v1beta1.TarantoolBackup, error) {
+		if fakeClient == nil {
+			fakeClient = cartridge.BuildFakeClient()
+		}
+
+		reconciler := newTarantoolBackupReconciler(fakeClient, labelsManager, fakeTopologyService)
+
+		_, reconcileErr := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, backupName))
+
+		backup := &v1beta1.TarantoolBackup{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: backupName}, backup)
+		Expect(err).NotTo(HaveOccurred(), "backup gone")
+
+		return backup, reconcileErr
+	}
+
+	AfterEach(func() {
+		fakeClient = nil
+	})
+
+	It("must snapshot masters and upload their files", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Instances: v1beta1.BackupInstancesMaster,
+			Storage:   pvcStorage,
+		})
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupUploading))
+		Expect(backup.Status.Instances).To(HaveLen(1))
+
+		instance := backup.Status.Instances[0]
+		Expect(instance.Pod).To(Equal("router-0-0"))
+		Expect(instance.UUID).To(Equal("uuid-0"))
+		Expect(instance.Volume).To(Equal("data"))
+		Expect(instance.MountPath).To(Equal("/var/lib/tarantool"))
+		Expect(backup.Status.Location).To(Equal(fmt.Sprintf("%s/%s/%s", namespace, clusterName, backupName)))
+
+		configMap := &v1.ConfigMap{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: backup.Status.TopologyConfigMap}, configMap)
+		Expect(err).NotTo(HaveOccurred(), "topology is not recorded")
+		Expect(configMap.Data["topology.json"]).To(ContainSubstring("rs-uuid"))
+		Expect(configMap.Data["config.yml"]).To(ContainSubstring("custom: value"))
+
+		jobName := fmt.Sprintf("%s-router-0-0", backupName)
+		job := &batchv1.Job{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: jobName}, job)
+		Expect(err).NotTo(HaveOccurred(), "upload job is not created")
+		Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("data-router-0-0"))
+
+		setJobCondition(ctx, fakeClient, namespace, jobName, batchv1.JobComplete)
+
+		backup, err = reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupCompleted))
+		Expect(backup.Status.CompletedAt).NotTo(BeNil())
+		fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "StartBackup", 1)
+		fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "StopBackup", 1)
+	})
+
+	It("must snapshot all instances", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Instances: v1beta1.BackupInstancesAll,
+			Storage:   pvcStorage,
+		})
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.GetInstancePods()).To(ConsistOf("router-0-0", "router-0-1"))
+	})
+
+	It("must fail when upload job failed", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Instances: v1beta1.BackupInstancesMaster,
+			Storage:   pvcStorage,
+		})
+
+		_, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+
+		setJobCondition(ctx, fakeClient, namespace, fmt.Sprintf("%s-router-0-0", backupName), batchv1.JobFailed)
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupFailed))
+		Expect(backup.Status.Message).To(ContainSubstring("upload jobs failed"))
+		fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "StopBackup", 1)
+	})
+
+	It("must fail when no instances match roles", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Roles:   []string{"storage"},
+			Storage: pvcStorage,
+		})
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupFailed))
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "StartBackup", mock.Anything, mock.Anything)
+	})
+
+	It("must fail for cluster which is not managed by Cartridge", func() {
+		cartridge.Cluster.Spec.Flavor = api.ClusterFlavorPlain
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Storage: pvcStorage,
+		})
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupFailed))
+		Expect(backup.Status.Message).To(ContainSubstring("backups are supported only for cartridge flavor"))
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "StartBackup", mock.Anything, mock.Anything)
+	})
+
+	It("must take volume snapshots of instances", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Instances: v1beta1.BackupInstancesMaster,
+			Storage: v1beta1.BackupStorage{
+				VolumeSnapshot: &v1beta1.BackupVolumeSnapshotStorage{
+					ClassName: "csi-snapclass",
+				},
+			},
+		})
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupUploading))
+		Expect(backup.Status.Location).To(BeEmpty())
+
+		snapshotName := fmt.Sprintf("%s-router-0-0", backupName)
+		Expect(backup.Status.Instances[0].VolumeSnapshot).To(Equal(snapshotName))
+
+		snapshot := pkgutils.NewVolumeSnapshot()
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: snapshotName}, snapshot)
+		Expect(err).NotTo(HaveOccurred(), "volume snapshot is not created")
+
+		claimName, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+		Expect(claimName).To(Equal("data-router-0-0"))
+		className, _, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+		Expect(className).To(Equal("csi-snapclass"))
+		fakeTopologyService.AssertNotCalled(GinkgoT(), "StopBackup", mock.Anything, mock.Anything)
+
+		err = unstructured.SetNestedField(snapshot.Object, true, "status", "readyToUse")
+		Expect(err).NotTo(HaveOccurred())
+		err = fakeClient.Update(ctx, snapshot)
+		Expect(err).NotTo(HaveOccurred())
+
+		backup, err = reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupCompleted))
+		fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "StopBackup", 1)
+
+		jobs := &batchv1.JobList{}
+		err = fakeClient.List(ctx, jobs, client.InNamespace(namespace))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobs.Items).To(BeEmpty(), "upload jobs must not be created")
+	})
+
+	It("must fail when volume snapshot failed", func() {
+		cartridge.WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+			Storage: v1beta1.BackupStorage{
+				VolumeSnapshot: &v1beta1.BackupVolumeSnapshotStorage{},
+			},
+		})
+
+		_, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot := pkgutils.NewVolumeSnapshot()
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf("%s-router-0-0", backupName)}, snapshot)
+		Expect(err).NotTo(HaveOccurred(), "volume snapshot is not created")
+
+		err = unstructured.SetNestedField(snapshot.Object, "driver does not support snapshots", "status", "error", "message")
+		Expect(err).NotTo(HaveOccurred())
+		err = fakeClient.Update(ctx, snapshot)
+		Expect(err).NotTo(HaveOccurred())
+
+		backup, err := reconcileBackup()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Status.Phase).To(Equal(v1beta1.TarantoolBackupFailed))
+		Expect(backup.Status.Message).To(ContainSubstring("driver does not support snapshots"))
+		fakeTopologyService.AssertNumberOfCalls(GinkgoT(), "StopBackup", 1)
+	})
+})
@@ -0,0 +1,282 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newTarantoolBackupPolicyReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *TarantoolBackupPolicyReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &TarantoolBackupPolicyReconciler{
+		SteppedReconciler: &reconciliation.SteppedReconciler[*BackupPolicyContextCE, *BackupPolicyControllerCE]{
+			Client: fakeClient,
+			Controller: &BackupPolicyControllerCE{
+				CommonBackupPolicyController: &reconciliation.CommonBackupPolicyController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager: resourcesManager,
+						LabelsManager:    labelsManager,
+						EventsRecorder:   eventsRecorder,
+					},
+				},
+				BackupPolicyManager: &BackupPolicyManager{
+					ResourcesManager: resourcesManager,
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("tarantoolbackuppolicy_controller unit testing", func() {
+	var (
+		ctx         = context.Background()
+		namespace   = "default"
+		clusterName string
+		policyName  string
+		cartridge   *resources.FakeCartridge
+		fakeClient  client.WithWatch
+		now         time.Time
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	policySpec := func(schedule string, retention v1beta1.BackupRetention) v1beta1.TarantoolBackupPolicySpec {
+		return v1beta1.TarantoolBackupPolicySpec{
+			Schedule:  schedule,
+			Retention: retention,
+			BackupTemplate: v1beta1.TarantoolBackupSpec{
+				Storage: v1beta1.BackupStorage{
+					PVC: &v1beta1.BackupPVCStorage{
+						ClaimName: "backups",
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		policyName = fmt.Sprintf("policy-%s", utils.RandStringRunes(4))
+		now = time.Now().Truncate(time.Second)
+
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			Bootstrapped()
+	})
+
+	AfterEach(func() {
+		fakeClient = nil
+	})
+
+	reconcilePolicy := func() (*v1beta1.TarantoolBackupPolicy, ctrl.Result, error) {
+		if fakeClient == nil {
+			fakeClient = cartridge.BuildFakeClient()
+		}
+
+		reconciler := newTarantoolBackupPolicyReconciler(fakeClient, labelsManager, mocks.NewFakeCartridgeTopology())
+
+		result, reconcileErr := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, policyName))
+
+		policy := &v1beta1.TarantoolBackupPolicy{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: policyName}, policy)
+		Expect(err).NotTo(HaveOccurred(), "policy gone")
+
+		return policy, result, reconcileErr
+	}
+
+	listBackups := func() []v1beta1.TarantoolBackup {
+		backupList := &v1beta1.TarantoolBackupList{}
+		err := fakeClient.List(ctx, backupList, &client.ListOptions{
+			Namespace:     namespace,
+			LabelSelector: labels.SelectorFromSet(map[string]string{labelsManager.BackupPolicyName(): policyName}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		return backupList.Items
+	}
+
+	It("must create backup when schedule time has come", func() {
+		cartridge.WithTarantoolBackupPolicy(policyName, policySpec("@hourly", v1beta1.BackupRetention{}), now.Add(-90*time.Minute))
+
+		policy, result, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.Phase).To(Equal(v1beta1.TarantoolBackupPolicyScheduled))
+		Expect(policy.Status.LastScheduleTime).NotTo(BeNil())
+		Expect(policy.Status.NextScheduleTime.After(now)).To(BeTrue())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+
+		backups := listBackups()
+		Expect(backups).To(HaveLen(1))
+		Expect(backups[0].GetLabels()[labelsManager.ClusterName()]).To(Equal(clusterName))
+		Expect(backups[0].Spec.Storage.PVC.ClaimName).To(Equal("backups"))
+
+		By("not creating the same backup twice")
+		_, _, err = reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listBackups()).To(HaveLen(1))
+	})
+
+	It("must not create backup before schedule time", func() {
+		cartridge.WithTarantoolBackupPolicy(policyName, policySpec("@daily", v1beta1.BackupRetention{}), now)
+
+		policy, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.LastScheduleTime).To(BeNil())
+		Expect(listBackups()).To(BeEmpty())
+	})
+
+	It("must not create backup while previous one is running", func() {
+		cartridge.
+			WithTarantoolBackupPolicy(policyName, policySpec("@hourly", v1beta1.BackupRetention{}), now.Add(-90*time.Minute)).
+			WithPolicyBackup(policyName, now.Add(-10*time.Minute), v1beta1.TarantoolBackupUploading, "")
+
+		policy, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.Active).To(HaveLen(1))
+		Expect(listBackups()).To(HaveLen(1))
+	})
+
+	It("must not schedule backups when suspended", func() {
+		spec := policySpec("@hourly", v1beta1.BackupRetention{})
+		spec.Suspend = true
+		cartridge.WithTarantoolBackupPolicy(policyName, spec, now.Add(-90*time.Minute))
+
+		policy, result, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.Phase).To(Equal(v1beta1.TarantoolBackupPolicySuspended))
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(listBackups()).To(BeEmpty())
+	})
+
+	It("must report invalid schedule", func() {
+		cartridge.WithTarantoolBackupPolicy(policyName, policySpec("every day", v1beta1.BackupRetention{}), now)
+
+		policy, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.Phase).To(Equal(v1beta1.TarantoolBackupPolicyInvalid))
+		Expect(policy.Status.Message).NotTo(BeEmpty())
+	})
+
+	It("must report last successful and failed backups", func() {
+		cartridge.
+			WithTarantoolBackupPolicy(policyName, policySpec("@daily", v1beta1.BackupRetention{}), now).
+			WithPolicyBackup(policyName, now.Add(-3*time.Hour), v1beta1.TarantoolBackupCompleted, "").
+			WithPolicyBackup(policyName, now.Add(-2*time.Hour), v1beta1.TarantoolBackupCompleted, "").
+			WithPolicyBackup(policyName, now.Add(-1*time.Hour), v1beta1.TarantoolBackupFailed, "")
+
+		policy, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Status.LastSuccessfulBackup).To(Equal(fmt.Sprintf("%s-%d", policyName, now.Add(-2*time.Hour).Unix())))
+		Expect(policy.Status.LastFailedBackup).To(Equal(fmt.Sprintf("%s-%d", policyName, now.Add(-1*time.Hour).Unix())))
+	})
+
+	It("must keep last backups", func() {
+		keepLast := int32(2)
+		cartridge.
+			WithTarantoolBackupPolicy(policyName, policySpec("@daily", v1beta1.BackupRetention{KeepLast: &keepLast}), now).
+			WithPolicyBackup(policyName, now.Add(-3*time.Hour), v1beta1.TarantoolBackupCompleted, "").
+			WithPolicyBackup(policyName, now.Add(-2*time.Hour), v1beta1.TarantoolBackupCompleted, "").
+			WithPolicyBackup(policyName, now.Add(-1*time.Hour), v1beta1.TarantoolBackupFailed, "")
+
+		_, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+
+		backups := listBackups()
+		Expect(backups).To(HaveLen(2))
+		for _, backup := range backups {
+			Expect(backup.GetCreationTimestamp().Time).NotTo(Equal(now.Add(-3 * time.Hour)))
+		}
+	})
+
+	It("must never prune the most recent completed backup", func() {
+		cartridge.
+			WithTarantoolBackupPolicy(policyName, policySpec("@daily", v1beta1.BackupRetention{
+				KeepFor: &metav1.Duration{Duration: time.Hour},
+			}), now).
+			WithPolicyBackup(policyName, now.Add(-3*time.Hour), v1beta1.TarantoolBackupCompleted, "").
+			WithPolicyBackup(policyName, now.Add(-2*time.Hour), v1beta1.TarantoolBackupFailed, "")
+
+		_, _, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+
+		backups := listBackups()
+		Expect(backups).To(HaveLen(1))
+		Expect(backups[0].Status.Phase).To(Equal(v1beta1.TarantoolBackupCompleted))
+	})
+
+	It("must remove files of pruned backup from storage", func() {
+		keepLast := int32(1)
+		cartridge.
+			WithTarantoolBackupPolicy(policyName, policySpec("@daily", v1beta1.BackupRetention{KeepLast: &keepLast}), now).
+			WithPolicyBackup(policyName, now.Add(-2*time.Hour), v1beta1.TarantoolBackupCompleted, "default/cluster/old").
+			WithPolicyBackup(policyName, now.Add(-1*time.Hour), v1beta1.TarantoolBackupCompleted, "default/cluster/new")
+
+		_, result, err := reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+		Expect(listBackups()).To(HaveLen(2))
+
+		oldBackup := fmt.Sprintf("%s-%d", policyName, now.Add(-2*time.Hour).Unix())
+		jobName := fmt.Sprintf("%s-prune", oldBackup)
+		job := &batchv1.Job{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: jobName}, job)
+		Expect(err).NotTo(HaveOccurred(), "prune job is not created")
+		Expect(job.Spec.Template.Spec.Containers[0].Command[2]).To(ContainSubstring("rm -rf '/backup/default/cluster/old'"))
+
+		setJobCondition(ctx, fakeClient, namespace, jobName, batchv1.JobComplete)
+
+		_, _, err = reconcilePolicy()
+		Expect(err).NotTo(HaveOccurred())
+
+		backups := listBackups()
+		Expect(backups).To(HaveLen(1))
+		Expect(backups[0].Status.Location).To(Equal("default/cluster/new"))
+	})
+})
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	. "github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	. "github.com/tarantool/tarantool-operator/internal/steps"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/common"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport/podexec/cli"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+//+kubebuilder:rbac:groups=tarantool.io,resources=clusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups=tarantool.io,resources=roles,verbs=get;list;watch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolbackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolrestores,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolrestores/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=tarantool.io,resources=tarantoolrestores/finalizers,verbs=update
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;create;update;watch;list;patch;delete
+
+func NewTarantoolRestoreReconciler(mgr Manager) *TarantoolRestoreReconciler {
+	k8sConfig := mgr.GetConfig()
+	k8sClient := mgr.GetClient()
+	k8sScheme := mgr.GetScheme()
+	restClient, _ := apiutil.RESTClientForGVK(
+		schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Pod",
+		},
+		false,
+		k8sConfig,
+		serializer.NewCodecFactory(k8sScheme),
+		&http.Client{},
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+	resourcesManager := &implementation.ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: k8sClient,
+			Scheme: k8sScheme,
+		},
+	}
+	eventsRecorder := events.NewRecorder(mgr.GetEventRecorderFor("restore-controller"))
+	luaTopology := &topology.CommonCartridgeTopology{
+		Transport: &podexec.PodExec{
+			RestClient:    restClient,
+			RestConfig:    k8sConfig,
+			RuntimeScheme: k8sScheme,
+			CLI:           &cli.TarantoolCTL{},
+		},
+	}
+
+	return &TarantoolRestoreReconciler{
+		SteppedReconciler: &SteppedReconciler[*RestoreContextCE, *RestoreControllerCE]{
+			Client: k8sClient,
+			Controller: &RestoreControllerCE{
+				CommonRestoreController: &CommonRestoreController{
+					CommonController: &CommonController{
+						Client: k8sClient,
+						Schema: k8sScheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           k8sClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       luaTopology,
+						},
+						ResourcesManager: resourcesManager,
+						EventsRecorder:   eventsRecorder,
+						LabelsManager:    labelsManager,
+					},
+				},
+				RestoreManager: &implementation.RestoreManager{
+					ResourcesManager: resourcesManager,
+				},
+			},
+		},
+	}
+}
+
+// TarantoolRestoreReconciler reconciles a TarantoolRestore object.
+type TarantoolRestoreReconciler struct {
+	*SteppedReconciler[*RestoreContextCE, *RestoreControllerCE]
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *TarantoolRestoreReconciler) Reconcile(ctx context.Context, req Request) (Result, error) {
+	return r.Run(
+		&RestoreContextCE{
+			CommonContext: &CommonContext{
+				Context: ctx,
+				Request: req,
+				Logger:  logr.FromContextOrDiscard(ctx),
+			},
+		},
+		Info[*RestoreContextCE, *RestoreControllerCE]("Reconcile restore"),
+		GetRequestedObject[*RestoreContextCE, *RestoreControllerCE](&TarantoolRestore{}),
+		SkipFinishedRestore(),
+		SetRestorePhase(TarantoolRestoreWaitingForCluster),
+		GetClusterByLabels[*RestoreContextCE, *RestoreControllerCE](),
+		CheckClusterNotBootstrapped(),
+		SetRestorePhase(TarantoolRestoreWaitingForBackup),
+		GetRestoreBackup(),
+		SetRestorePhase(TarantoolRestorePreparingVolumes),
+		PrepareRestoreVolumes(),
+		SetRestorePhase(TarantoolRestoreDownloading),
+		DownloadBackupFiles(),
+		SetRestorePhase(TarantoolRestoreCompleted),
+		Info[*RestoreContextCE, *RestoreControllerCE]("Restore completed"),
+	)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TarantoolRestoreReconciler) SetupWithManager(mgr Manager) error {
+	return NewControllerManagedBy(mgr).
+		For(&TarantoolRestore{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,248 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/controllers"
+	. "github.com/tarantool/tarantool-operator/internal"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/election"
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newTarantoolRestoreReconciler(
+	fakeClient client.Client,
+	labelsManager k8s.LabelsManager,
+	fakeTopologyService *mocks.FakeCartridgeTopology,
+) *TarantoolRestoreReconciler {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	eventsRecorder := events.NewRecorder(record.NewFakeRecorder(10))
+
+	return &TarantoolRestoreReconciler{
+		SteppedReconciler: &reconciliation.SteppedReconciler[*RestoreContextCE, *RestoreControllerCE]{
+			Client: fakeClient,
+			Controller: &RestoreControllerCE{
+				CommonRestoreController: &reconciliation.CommonRestoreController{
+					CommonController: &reconciliation.CommonController{
+						Client: fakeClient,
+						Schema: scheme.Scheme,
+						LeaderElection: &election.LeaderElection{
+							Client:           fakeClient,
+							Recorder:         eventsRecorder,
+							ResourcesManager: resourcesManager,
+							Membership:       fakeTopologyService,
+						},
+						ResourcesManager: resourcesManager,
+						LabelsManager:    labelsManager,
+						EventsRecorder:   eventsRecorder,
+					},
+				},
+				RestoreManager: &RestoreManager{
+					ResourcesManager: resourcesManager,
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("tarantoolrestore_controller unit testing", func() {
+	var (
+		ctx         = context.Background()
+		namespace   = "default"
+		clusterName string
+		backupName  string
+		restoreName string
+		cartridge   *resources.FakeCartridge
+		fakeClient  client.WithWatch
+	)
+
+	labelsManager := &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		backupName = fmt.Sprintf("backup-%s", utils.RandStringRunes(4))
+		restoreName = fmt.Sprintf("restore-%s", utils.RandStringRunes(4))
+
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			WithRouterRole(1, 1).
+			WithDataVolumes().
+			WithTarantoolBackup(backupName, v1beta1.TarantoolBackupSpec{
+				Storage: v1beta1.BackupStorage{
+					S3: &v1beta1.BackupS3Storage{
+						Endpoint:          "http://minio:9000",
+						Bucket:            "backups",
+						CredentialsSecret: "minio",
+					},
+				},
+			}).
+			WithTarantoolRestore(restoreName, backupName)
+
+		backup := cartridge.TarantoolBackups[0]
+		backup.Status = v1beta1.TarantoolBackupStatus{
+			Phase:    v1beta1.TarantoolBackupCompleted,
+			Location: fmt.Sprintf("%s/%s/%s", namespace, clusterName, backupName),
+			Instances: []v1beta1.BackupInstance{
+				{
+					Pod:       "router-0-0",
+					Role:      resources.RoleRouter,
+					Workdir:   "/var/lib/tarantool/instance",
+					Volume:    "data",
+					MountPath: "/var/lib/tarantool",
+					Uploaded:  true,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		fakeClient = nil
+	})
+
+	reconcileRestore := func() (*v1beta1.TarantoolRestore, error) {
+		if fakeClient == nil {
+			fakeClient = cartridge.BuildFakeClient()
+		}
+
+		reconciler := newTarantoolRestoreReconciler(fakeClient, labelsManager, mocks.NewFakeCartridgeTopology())
+
+		_, reconcileErr := reconciler.Reconcile(ctx, utils.ReconcileRequest(namespace, restoreName))
+
+		restore := &v1beta1.TarantoolRestore{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: restoreName}, restore)
+		Expect(err).NotTo(HaveOccurred(), "restore gone")
+
+		return restore, reconcileErr
+	}
+
+	It("must populate volumes from backup", func() {
+		restore, err := reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Status.Phase).To(Equal(v1beta1.TarantoolRestoreDownloading))
+		Expect(restore.GetInstancePods()).To(ConsistOf("router-0-0"))
+
+		claim := &v1.PersistentVolumeClaim{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "data-router-0-0"}, claim)
+		Expect(err).NotTo(HaveOccurred(), "volume is not created")
+		Expect(claim.GetLabels()[labelsManager.RestoreName()]).To(Equal(restoreName))
+
+		jobName := fmt.Sprintf("%s-router-0-0", restoreName)
+		job := &batchv1.Job{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: jobName}, job)
+		Expect(err).NotTo(HaveOccurred(), "download job is not created")
+		Expect(job.Spec.Template.Spec.Containers[0].Command[2]).To(ContainSubstring("mc cp"))
+
+		setJobCondition(ctx, fakeClient, namespace, jobName, batchv1.JobComplete)
+
+		restore, err = reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Status.Phase).To(Equal(v1beta1.TarantoolRestoreCompleted))
+	})
+
+	It("must provision volumes from volume snapshots", func() {
+		backup := cartridge.TarantoolBackups[0]
+		backup.Spec.Storage = v1beta1.BackupStorage{
+			VolumeSnapshot: &v1beta1.BackupVolumeSnapshotStorage{},
+		}
+		backup.Status.Location = ""
+		backup.Status.Instances[0].VolumeSnapshot = fmt.Sprintf("%s-router-0-0", backupName)
+
+		restore, err := reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Status.Phase).To(Equal(v1beta1.TarantoolRestoreCompleted))
+
+		claim := &v1.PersistentVolumeClaim{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "data-router-0-0"}, claim)
+		Expect(err).NotTo(HaveOccurred(), "volume is not created")
+		Expect(claim.Spec.DataSource).NotTo(BeNil())
+		Expect(claim.Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+		Expect(claim.Spec.DataSource.Name).To(Equal(fmt.Sprintf("%s-router-0-0", backupName)))
+
+		jobs := &batchv1.JobList{}
+		err = fakeClient.List(ctx, jobs, client.InNamespace(namespace))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobs.Items).To(BeEmpty(), "download jobs must not be created")
+	})
+
+	It("must clone backup of another namespace and roles", func() {
+		backup := cartridge.TarantoolBackups[0]
+		backup.SetNamespace("production")
+		backup.SetLabels(map[string]string{labelsManager.ClusterName(): "prod"})
+		backup.Status.Instances[0].Pod = "storage-0-0"
+		backup.Status.Instances[0].Role = "storage"
+
+		restore := cartridge.TarantoolRestores[0]
+		restore.Spec.BackupNamespace = "production"
+		restore.Spec.Roles = map[string]string{"storage": resources.RoleRouter}
+
+		restore, err := reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.GetInstancePods()).To(ConsistOf("router-0-0"))
+		Expect(restore.GetBackupPod("router-0-0")).To(Equal("storage-0-0"))
+
+		claim := &v1.PersistentVolumeClaim{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "data-router-0-0"}, claim)
+		Expect(err).NotTo(HaveOccurred(), "volume of restored role is not created")
+
+		job := &batchv1.Job{}
+		err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf("%s-router-0-0", restoreName)}, job)
+		Expect(err).NotTo(HaveOccurred(), "download job is not created")
+		Expect(job.Spec.Template.Spec.InitContainers[0].Command[2]).To(ContainSubstring("/storage-0-0'"))
+
+		rewrite := job.Spec.Template.Spec.Containers[0].Command[2]
+		Expect(rewrite).To(ContainSubstring(`storage-([0-9]+-[0-9]+)\.prod\.production\.svc\.#\1router-\2`))
+		Expect(rewrite).To(ContainSubstring(fmt.Sprintf(".%s.%s.svc.", clusterName, namespace)))
+	})
+
+	It("must wait for backup to complete", func() {
+		cartridge.TarantoolBackups[0].Status.Phase = v1beta1.TarantoolBackupUploading
+
+		restore, err := reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Status.Phase).To(Equal(v1beta1.TarantoolRestoreWaitingForBackup))
+	})
+
+	It("must not restore into bootstrapped cluster", func() {
+		cartridge.Bootstrapped()
+
+		restore, err := reconcileRestore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restore.Status.Phase).To(Equal(v1beta1.TarantoolRestoreFailed))
+	})
+
+	It("must not overwrite existing volume", func() {
+		fakeClient = cartridge.BuildFakeClient()
+		claim := cartridge.NewDataVolumeClaim()
+		claim.SetName("data-router-0-0")
+		claim.SetNamespace(namespace)
+		Expect(fakeClient.Create(ctx, &claim)).To(Succeed())
+
+		_, err := reconcileRestore()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -19,3 +19,23 @@ type (
 	CartridgeConfigControllerCE = controller.CartridgeConfigController
 	CartridgeConfigContextCE    = context.CartridgeConfigContext
 )
+
+type (
+	CartridgeSchemaControllerCE = controller.CartridgeSchemaController
+	CartridgeSchemaContextCE    = context.CartridgeSchemaContext
+)
+
+type (
+	BackupControllerCE = controller.BackupController
+	BackupContextCE    = context.BackupContext
+)
+
+type (
+	RestoreControllerCE = controller.RestoreController
+	RestoreContextCE    = context.RestoreContext
+)
+
+type (
+	BackupPolicyControllerCE = controller.BackupPolicyController
+	BackupPolicyContextCE    = context.BackupPolicyContext
+)
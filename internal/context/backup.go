@@ -0,0 +1,41 @@
+package context
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type BackupContext struct {
+	*reconciliation.CommonContext
+
+	TarantoolBackup *v1beta1.TarantoolBackup
+}
+
+func (r *BackupContext) SetTarantoolBackup(backup *v1beta1.TarantoolBackup) {
+	r.TarantoolBackup = backup
+}
+
+func (r *BackupContext) GetTarantoolBackup() *v1beta1.TarantoolBackup {
+	return r.TarantoolBackup
+}
+
+func (r *BackupContext) HasRequestedObject() bool {
+	return r.TarantoolBackup != nil
+}
+
+func (r *BackupContext) SetRequestedObject(obj client.Object) error {
+	backup, ok := obj.(*v1beta1.TarantoolBackup)
+	if !ok {
+		return errors.New("BackupContext used with wrong k8s object")
+	}
+
+	r.TarantoolBackup = backup
+
+	return nil
+}
+
+func (r *BackupContext) GetRequestedObject() client.Object {
+	return r.TarantoolBackup
+}
@@ -0,0 +1,60 @@
+package context
+
+import (
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type BackupPolicyContext struct {
+	*reconciliation.CommonContext
+
+	TarantoolBackupPolicy *v1beta1.TarantoolBackupPolicy
+	Schedule              cron.Schedule
+	Backups               []*v1beta1.TarantoolBackup
+}
+
+func (r *BackupPolicyContext) SetTarantoolBackupPolicy(policy *v1beta1.TarantoolBackupPolicy) {
+	r.TarantoolBackupPolicy = policy
+}
+
+func (r *BackupPolicyContext) GetTarantoolBackupPolicy() *v1beta1.TarantoolBackupPolicy {
+	return r.TarantoolBackupPolicy
+}
+
+func (r *BackupPolicyContext) SetSchedule(schedule cron.Schedule) {
+	r.Schedule = schedule
+}
+
+func (r *BackupPolicyContext) GetSchedule() cron.Schedule {
+	return r.Schedule
+}
+
+func (r *BackupPolicyContext) SetBackups(backups []*v1beta1.TarantoolBackup) {
+	r.Backups = backups
+}
+
+func (r *BackupPolicyContext) GetBackups() []*v1beta1.TarantoolBackup {
+	return r.Backups
+}
+
+func (r *BackupPolicyContext) HasRequestedObject() bool {
+	return r.TarantoolBackupPolicy != nil
+}
+
+func (r *BackupPolicyContext) SetRequestedObject(obj client.Object) error {
+	policy, ok := obj.(*v1beta1.TarantoolBackupPolicy)
+	if !ok {
+		return errors.New("BackupPolicyContext used with wrong k8s object")
+	}
+
+	r.TarantoolBackupPolicy = policy
+
+	return nil
+}
+
+func (r *BackupPolicyContext) GetRequestedObject() client.Object {
+	return r.TarantoolBackupPolicy
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tarantool/tarantool-operator/apis/v1beta1"
 	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -11,16 +12,25 @@ type CartridgeConfigContext struct {
 	*reconciliation.CommonContext
 
 	CartridgeConfig *v1beta1.CartridgeConfig
+	DesiredConfig   topology.ClusterwideConfigData
 }
 
 func (r *CartridgeConfigContext) SetCartridgeConfig(config *v1beta1.CartridgeConfig) {
 	r.CartridgeConfig = config
 }
 
-func (r *CartridgeConfigContext) GetCartridgeConfig() *v1beta1.CartridgeConfig {
+func (r *CartridgeConfigContext) GetConfig() *v1beta1.CartridgeConfig {
 	return r.CartridgeConfig
 }
 
+func (r *CartridgeConfigContext) SetDesiredConfig(config topology.ClusterwideConfigData) {
+	r.DesiredConfig = config
+}
+
+func (r *CartridgeConfigContext) GetDesiredConfig() topology.ClusterwideConfigData {
+	return r.DesiredConfig
+}
+
 func (r *CartridgeConfigContext) HasRequestedObject() bool {
 	return r.CartridgeConfig != nil
 }
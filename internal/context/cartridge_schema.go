@@ -0,0 +1,41 @@
+package context
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type CartridgeSchemaContext struct {
+	*reconciliation.CommonContext
+
+	CartridgeSchema *v1beta1.CartridgeSchema
+}
+
+func (r *CartridgeSchemaContext) SetCartridgeSchema(schema *v1beta1.CartridgeSchema) {
+	r.CartridgeSchema = schema
+}
+
+func (r *CartridgeSchemaContext) GetSchema() *v1beta1.CartridgeSchema {
+	return r.CartridgeSchema
+}
+
+func (r *CartridgeSchemaContext) HasRequestedObject() bool {
+	return r.CartridgeSchema != nil
+}
+
+func (r *CartridgeSchemaContext) SetRequestedObject(obj client.Object) error {
+	cartridgeSchema, ok := obj.(*v1beta1.CartridgeSchema)
+	if !ok {
+		return errors.New("CartridgeSchemaContext used with wrong k8s object")
+	}
+
+	r.CartridgeSchema = cartridgeSchema
+
+	return nil
+}
+
+func (r *CartridgeSchemaContext) GetRequestedObject() client.Object {
+	return r.CartridgeSchema
+}
@@ -0,0 +1,50 @@
+package context
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type RestoreContext struct {
+	*reconciliation.CommonContext
+
+	TarantoolRestore *v1beta1.TarantoolRestore
+	TarantoolBackup  *v1beta1.TarantoolBackup
+}
+
+func (r *RestoreContext) SetTarantoolRestore(restore *v1beta1.TarantoolRestore) {
+	r.TarantoolRestore = restore
+}
+
+func (r *RestoreContext) GetTarantoolRestore() *v1beta1.TarantoolRestore {
+	return r.TarantoolRestore
+}
+
+func (r *RestoreContext) SetTarantoolBackup(backup *v1beta1.TarantoolBackup) {
+	r.TarantoolBackup = backup
+}
+
+func (r *RestoreContext) GetTarantoolBackup() *v1beta1.TarantoolBackup {
+	return r.TarantoolBackup
+}
+
+func (r *RestoreContext) HasRequestedObject() bool {
+	return r.TarantoolRestore != nil
+}
+
+func (r *RestoreContext) SetRequestedObject(obj client.Object) error {
+	restore, ok := obj.(*v1beta1.TarantoolRestore)
+	if !ok {
+		return errors.New("RestoreContext used with wrong k8s object")
+	}
+
+	r.TarantoolRestore = restore
+
+	return nil
+}
+
+func (r *RestoreContext) GetRequestedObject() client.Object {
+	return r.TarantoolRestore
+}
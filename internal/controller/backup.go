@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type BackupController struct {
+	*reconciliation.CommonBackupController
+
+	BackupManager *implementation.BackupManager
+}
+
+func (r *BackupController) GetBackupManager() k8s.BackupManager[*v1beta1.TarantoolBackup] {
+	return r.BackupManager
+}
@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type BackupPolicyController struct {
+	*reconciliation.CommonBackupPolicyController
+
+	BackupPolicyManager *implementation.BackupPolicyManager
+}
+
+func (r *BackupPolicyController) GetBackupPolicyManager() k8s.BackupPolicyManager[*v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup] {
+	return r.BackupPolicyManager
+}
@@ -0,0 +1,9 @@
+package controller
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type CartridgeSchemaController struct {
+	*reconciliation.CommonCartridgeSchemaController
+}
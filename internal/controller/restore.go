@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type RestoreController struct {
+	*reconciliation.CommonRestoreController
+
+	RestoreManager *implementation.RestoreManager
+}
+
+func (r *RestoreController) GetRestoreManager() k8s.RestoreManager[*v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup] {
+	return r.RestoreManager
+}
@@ -0,0 +1,317 @@
+package implementation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"gopkg.in/yaml.v3"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	topologyFileName = "topology.json"
+	configFileName   = "config.yml"
+
+	topologyVolumeName = "topology"
+	topologyMountPath  = "/topology"
+	dataVolumeName     = "data"
+)
+
+type BackupManager struct {
+	*ResourcesManager
+}
+
+func (r *BackupManager) RecordTopology(
+	ctx context.Context,
+	cluster api.Cluster,
+	backup *v1beta1.TarantoolBackup,
+	replicasets []topology.ReplicasetInfo,
+	config topology.ClusterwideConfigData,
+) error {
+	topologyData, err := json.MarshalIndent(replicasets, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	configData, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-topology", backup.GetName()),
+			Namespace: backup.GetNamespace(),
+			Labels: map[string]string{
+				r.LabelsManager.ClusterName(): cluster.GetName(),
+				r.LabelsManager.BackupName():  backup.GetName(),
+			},
+		},
+		Data: map[string]string{
+			topologyFileName: string(topologyData),
+			configFileName:   string(configData),
+		},
+	}
+
+	_, err = r.ControlObject(backup, configMap)
+	if err != nil {
+		return err
+	}
+
+	err = r.CreateObject(ctx, configMap)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	backup.Status.TopologyConfigMap = configMap.GetName()
+	backup.Status.Location = storageLocation(&backup.Spec.Storage, backup.GetNamespace(), cluster.GetName(), backup.GetName())
+
+	return nil
+}
+
+func (r *BackupManager) RecordInstance(
+	backup *v1beta1.TarantoolBackup,
+	replicaset *topology.ReplicasetInfo,
+	pod *v1.Pod,
+	files *topology.BackupFiles,
+) error {
+	volume, mountPath, err := findDataVolume(pod, files.Workdir)
+	if err != nil {
+		return err
+	}
+
+	var uuid string
+
+	for _, server := range replicaset.Servers {
+		if strings.HasPrefix(server.URI, pod.GetName()+".") {
+			uuid = server.UUID
+		}
+	}
+
+	instance := v1beta1.BackupInstance{
+		Pod:            pod.GetName(),
+		Role:           pod.GetLabels()[r.LabelsManager.RoleName()],
+		Replicaset:     replicaset.Alias,
+		ReplicasetUUID: replicaset.UUID,
+		UUID:           uuid,
+		Workdir:        files.Workdir,
+		Volume:         volume,
+		MountPath:      mountPath,
+		Files:          files.Files,
+	}
+
+	if existing := backup.GetInstance(pod.GetName()); existing != nil {
+		*existing = instance
+
+		return nil
+	}
+
+	backup.Status.Instances = append(backup.Status.Instances, instance)
+
+	return nil
+}
+
+func (r *BackupManager) EnsureUploadJob(ctx context.Context, backup *v1beta1.TarantoolBackup, podName string) (*batchv1.Job, error) {
+	jobName := storageJobName(backup.GetName(), podName)
+
+	job := &batchv1.Job{}
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: backup.GetNamespace(), Name: jobName}, job)
+	if err == nil {
+		return job, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	instance := backup.GetInstance(podName)
+	if instance == nil {
+		return nil, fmt.Errorf("instance %s is not recorded in backup", podName)
+	}
+
+	pod, err := r.GetPod(ctx, backup.GetNamespace(), podName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get pod %s", podName)
+	}
+
+	storage := &backup.Spec.Storage
+	instanceDir := path.Join(backup.Status.Location, podName)
+	configDir := path.Join(instance.Workdir, "config")
+
+	script := []string{
+		storageUploadCommand(storage, instance.Files, instanceDir),
+		fmt.Sprintf("if [ -d %s ]; then %s; fi", shellQuote(configDir), storageUploadCommand(storage, []string{configDir}, instanceDir)),
+		storageUploadCommand(storage, []string{
+			path.Join(topologyMountPath, topologyFileName),
+			path.Join(topologyMountPath, configFileName),
+		}, backup.Status.Location),
+	}
+
+	volumes := []v1.Volume{
+		{
+			Name: dataVolumeName,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: fmt.Sprintf("%s-%s", instance.Volume, podName),
+					ReadOnly:  true,
+				},
+			},
+		},
+		{
+			Name: topologyVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: backup.Status.TopologyConfigMap,
+					},
+				},
+			},
+		},
+	}
+	mounts := []v1.VolumeMount{
+		{
+			Name:      dataVolumeName,
+			MountPath: instance.MountPath,
+			ReadOnly:  true,
+		},
+		{
+			Name:      topologyVolumeName,
+			MountPath: topologyMountPath,
+			ReadOnly:  true,
+		},
+	}
+
+	job = newStorageJob(metav1.ObjectMeta{
+		Name:      jobName,
+		Namespace: backup.GetNamespace(),
+		Labels: map[string]string{
+			r.LabelsManager.ClusterName(): backup.GetLabels()[r.LabelsManager.ClusterName()],
+			r.LabelsManager.BackupName():  backup.GetName(),
+		},
+	}, storage, script, volumes, mounts)
+
+	// Data volume may be ReadWriteOnce, so the job must run on the node of instance
+	job.Spec.Template.Spec.NodeName = pod.Spec.NodeName
+	job.Spec.Template.Spec.SecurityContext = pod.Spec.SecurityContext
+
+	_, err = r.ControlObject(backup, job)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateObject(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r *BackupManager) EnsureVolumeSnapshot(ctx context.Context, backup *v1beta1.TarantoolBackup, podName string) (*unstructured.Unstructured, error) {
+	instance := backup.GetInstance(podName)
+	if instance == nil {
+		return nil, fmt.Errorf("instance %s is not recorded in backup", podName)
+	}
+
+	snapshot := utils.NewVolumeSnapshot()
+	snapshotName := storageJobName(backup.GetName(), podName)
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: backup.GetNamespace(), Name: snapshotName}, snapshot)
+	if err == nil {
+		instance.VolumeSnapshot = snapshotName
+
+		return snapshot, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	snapshot = utils.NewVolumeSnapshot()
+	snapshot.SetName(snapshotName)
+	snapshot.SetNamespace(backup.GetNamespace())
+	snapshot.SetLabels(map[string]string{
+		r.LabelsManager.ClusterName(): backup.GetLabels()[r.LabelsManager.ClusterName()],
+		r.LabelsManager.BackupName():  backup.GetName(),
+	})
+
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": fmt.Sprintf("%s-%s", instance.Volume, podName),
+		},
+	}
+	if className := backup.Spec.Storage.VolumeSnapshot.ClassName; className != "" {
+		spec["volumeSnapshotClassName"] = className
+	}
+
+	err = unstructured.SetNestedMap(snapshot.Object, spec, "spec")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.ControlObject(backup, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateObject(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	instance.VolumeSnapshot = snapshotName
+
+	return snapshot, nil
+}
+
+// findDataVolume returns name of volume claim template and mount path of volume which contains working directory.
+func findDataVolume(pod *v1.Pod, workdir string) (string, string, error) {
+	claims := map[string]string{}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+
+	var (
+		template  string
+		mountPath string
+	)
+
+	for _, container := range pod.Spec.Containers {
+		for _, mount := range container.VolumeMounts {
+			claim, ok := claims[mount.Name]
+			if !ok || len(mount.MountPath) <= len(mountPath) {
+				continue
+			}
+
+			if workdir != mount.MountPath && !strings.HasPrefix(workdir, strings.TrimRight(mount.MountPath, "/")+"/") {
+				continue
+			}
+
+			template = strings.TrimSuffix(claim, "-"+pod.GetName())
+			mountPath = mount.MountPath
+		}
+	}
+
+	if mountPath == "" {
+		return "", "", fmt.Errorf("working directory %s of pod %s is not on persistent volume", workdir, pod.GetName())
+	}
+
+	return template, mountPath, nil
+}
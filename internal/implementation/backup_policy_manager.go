@@ -0,0 +1,131 @@
+package implementation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type BackupPolicyManager struct {
+	*ResourcesManager
+}
+
+func (r *BackupPolicyManager) ListBackups(ctx context.Context, policy *v1beta1.TarantoolBackupPolicy) ([]*v1beta1.TarantoolBackup, error) {
+	selector := labels.SelectorFromSet(map[string]string{
+		r.LabelsManager.BackupPolicyName(): policy.GetName(),
+	})
+
+	backupList := &v1beta1.TarantoolBackupList{}
+
+	err := r.List(ctx, backupList, &client.ListOptions{LabelSelector: selector, Namespace: policy.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*v1beta1.TarantoolBackup, len(backupList.Items))
+	for k := range backupList.Items {
+		result[k] = &backupList.Items[k]
+	}
+
+	return result, nil
+}
+
+func (r *BackupPolicyManager) CreateBackup(
+	ctx context.Context,
+	cluster api.Cluster,
+	policy *v1beta1.TarantoolBackupPolicy,
+	scheduledAt time.Time,
+) (*v1beta1.TarantoolBackup, error) {
+	backup := &v1beta1.TarantoolBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", policy.GetName(), scheduledAt.Unix()),
+			Namespace: policy.GetNamespace(),
+			Labels: map[string]string{
+				r.LabelsManager.ClusterName():      cluster.GetName(),
+				r.LabelsManager.BackupPolicyName(): policy.GetName(),
+			},
+		},
+		Spec: *policy.Spec.BackupTemplate.DeepCopy(),
+	}
+
+	_, err := r.ControlObject(policy, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateObject(ctx, backup)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+func (r *BackupPolicyManager) PruneBackup(ctx context.Context, backup *v1beta1.TarantoolBackup) (bool, error) {
+	if backup.Status.Location != "" {
+		done, err := r.removeBackupFiles(ctx, backup)
+		if err != nil || !done {
+			return false, err
+		}
+	}
+
+	err := r.Delete(ctx, backup)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// removeBackupFiles runs job which removes location of backup from storage, job is removed together with backup.
+func (r *BackupPolicyManager) removeBackupFiles(ctx context.Context, backup *v1beta1.TarantoolBackup) (bool, error) {
+	jobName := storageJobName(backup.GetName(), "prune")
+
+	job := &batchv1.Job{}
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: backup.GetNamespace(), Name: jobName}, job)
+	if err == nil {
+		if utils.IsJobFailed(job) {
+			return false, fmt.Errorf("unable to remove files of backup %s, job %s failed", backup.GetName(), jobName)
+		}
+
+		return utils.IsJobComplete(job), nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	storage := &backup.Spec.Storage
+
+	job = newStorageJob(metav1.ObjectMeta{
+		Name:      jobName,
+		Namespace: backup.GetNamespace(),
+		Labels: map[string]string{
+			r.LabelsManager.ClusterName(): backup.GetLabels()[r.LabelsManager.ClusterName()],
+			r.LabelsManager.BackupName():  backup.GetName(),
+		},
+	}, storage, []string{storageRemoveCommand(storage, backup.Status.Location)}, nil, nil)
+
+	_, err = r.ControlObject(backup, job)
+	if err != nil {
+		return false, err
+	}
+
+	err = r.CreateObject(ctx, job)
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
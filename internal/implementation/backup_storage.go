@@ -0,0 +1,206 @@
+package implementation
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// DefaultPVCStorageImage is used by jobs of PVC storage and by restore jobs when storage has no image.
+	DefaultPVCStorageImage = "busybox:1.36"
+	// DefaultS3StorageImage is used by jobs of S3 storage when storage has no image.
+	DefaultS3StorageImage = "minio/mc:RELEASE.2023-10-24T21-42-22Z"
+)
+
+const (
+	storageVolumeName = "storage"
+	storageMountPath  = "/backup"
+	storageS3Alias    = "storage"
+
+	jobBackoffLimit = int32(3)
+	maxJobNameLen   = 63
+)
+
+// storageJobName returns name of job which is unique for owner and instance and fits into label value.
+func storageJobName(owner, pod string) string {
+	name := fmt.Sprintf("%s-%s", owner, pod)
+	if len(name) <= maxJobNameLen {
+		return name
+	}
+
+	hash, _ := utils.HashObject(name)
+	if len(hash) > 10 {
+		hash = hash[:10]
+	}
+
+	return fmt.Sprintf("%s-%s", strings.TrimRight(name[:maxJobNameLen-len(hash)-1], "-."), hash)
+}
+
+func storageImage(storage *v1beta1.BackupStorage) string {
+	if storage.Image != "" {
+		return storage.Image
+	}
+
+	if storage.S3 != nil {
+		return DefaultS3StorageImage
+	}
+
+	return DefaultPVCStorageImage
+}
+
+func storageLocation(storage *v1beta1.BackupStorage, namespace, cluster, backup string) string {
+	// Volume snapshots are not stored in any directory, they are removed together with backup
+	if storage.VolumeSnapshot != nil {
+		return ""
+	}
+
+	prefix := ""
+	if storage.PVC != nil {
+		prefix = storage.PVC.Path
+	}
+
+	if storage.S3 != nil {
+		prefix = storage.S3.Path
+	}
+
+	return path.Join("/", prefix, namespace, cluster, backup)[1:]
+}
+
+// storageURL returns address of location inside storage which is accessible from job container.
+func storageURL(storage *v1beta1.BackupStorage, location string) string {
+	if storage.S3 != nil {
+		return path.Join(storageS3Alias, storage.S3.Bucket, location)
+	}
+
+	return path.Join(storageMountPath, location)
+}
+
+// storageUploadCommand returns shell command which copies local sources into directory of storage.
+func storageUploadCommand(storage *v1beta1.BackupStorage, sources []string, dir string) string {
+	quoted := make([]string, len(sources))
+	for k, source := range sources {
+		quoted[k] = shellQuote(source)
+	}
+
+	target := storageURL(storage, dir)
+
+	if storage.S3 != nil {
+		return fmt.Sprintf("mc cp %s --recursive %s %s/", storageS3Flags(storage), strings.Join(quoted, " "), shellQuote(target))
+	}
+
+	return fmt.Sprintf("mkdir -p %s && cp -R %s %s/", shellQuote(target), strings.Join(quoted, " "), shellQuote(target))
+}
+
+// storageDownloadCommand returns shell command which copies content of storage directory into local directory.
+func storageDownloadCommand(storage *v1beta1.BackupStorage, dir string, target string) string {
+	source := storageURL(storage, dir)
+
+	if storage.S3 != nil {
+		return fmt.Sprintf("mkdir -p %s && mc cp %s --recursive %s/ %s/", shellQuote(target), storageS3Flags(storage), shellQuote(source), shellQuote(target))
+	}
+
+	return fmt.Sprintf("mkdir -p %s && cp -R %s/. %s/", shellQuote(target), shellQuote(source), shellQuote(target))
+}
+
+// storageRemoveCommand returns shell command which removes directory of storage with all content.
+func storageRemoveCommand(storage *v1beta1.BackupStorage, dir string) string {
+	target := storageURL(storage, dir)
+
+	if storage.S3 != nil {
+		return fmt.Sprintf("mc rm %s --recursive --force %s/", storageS3Flags(storage), shellQuote(target))
+	}
+
+	return fmt.Sprintf("rm -rf %s", shellQuote(target))
+}
+
+func storageS3Flags(storage *v1beta1.BackupStorage) string {
+	if storage.S3.Insecure {
+		return "--insecure"
+	}
+
+	return ""
+}
+
+// newStorageJob returns job which runs script with access to backup storage.
+func newStorageJob(meta metav1.ObjectMeta, storage *v1beta1.BackupStorage, script []string, volumes []v1.Volume, mounts []v1.VolumeMount) *batchv1.Job {
+	backoffLimit := jobBackoffLimit
+
+	var env []v1.EnvVar
+
+	if storage.PVC != nil {
+		volumes = append(volumes, v1.Volume{
+			Name: storageVolumeName,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: storage.PVC.ClaimName,
+				},
+			},
+		})
+		mounts = append(mounts, v1.VolumeMount{
+			Name:      storageVolumeName,
+			MountPath: storageMountPath,
+		})
+	}
+
+	if storage.S3 != nil {
+		env = []v1.EnvVar{
+			{Name: "MC_CONFIG_DIR", Value: "/tmp/.mc"},
+			{Name: "S3_ENDPOINT", Value: storage.S3.Endpoint},
+			secretEnv("S3_ACCESS_KEY", storage.S3.CredentialsSecret, "accessKey"),
+			secretEnv("S3_SECRET_KEY", storage.S3.CredentialsSecret, "secretKey"),
+		}
+		script = append([]string{
+			fmt.Sprintf(`mc alias set %s %s "$S3_ENDPOINT" "$S3_ACCESS_KEY" "$S3_SECRET_KEY" > /dev/null`, storageS3Flags(storage), storageS3Alias),
+		}, script...)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: meta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: meta.Labels,
+				},
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{
+						{
+							Name:         "storage",
+							Image:        storageImage(storage),
+							Command:      []string{"/bin/sh", "-ec", strings.Join(script, "\n")},
+							Env:          env,
+							VolumeMounts: mounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
+
+func secretEnv(name, secret, key string) v1.EnvVar {
+	return v1.EnvVar{
+		Name: name,
+		ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{
+					Name: secret,
+				},
+				Key: key,
+			},
+		},
+	}
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,335 @@
+package implementation
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// vshardShardingRoles maps vshard cluster roles of Cartridge to sharding roles of Tarantool 3.x.
+var vshardShardingRoles = map[string]string{
+	v1beta1.VShardStorageRole: "storage",
+	"vshard-router":           "router",
+}
+
+// SyncClusterConfig renders Tarantool 3.x config of cluster into ConfigMap and returns md5 checksum of it.
+func (r *ResourcesManager) SyncClusterConfig(ctx context.Context, cluster api.Cluster) (string, error) {
+	roles, err := r.GetClusterRoles(ctx, cluster)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := RenderClusterConfig(cluster, roles)
+	if err != nil {
+		return "", err
+	}
+
+	name := utils.ClusterConfigMapName(cluster.GetName())
+	saveFunc := r.UpdateObject
+
+	configMap := &v1.ConfigMap{}
+
+	err = r.Get(ctx, types.NamespacedName{Namespace: cluster.GetNamespace(), Name: name}, configMap)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+
+		saveFunc = r.CreateObject
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cluster.GetNamespace(),
+			},
+		}
+	}
+
+	changed, err := r.ControlObject(cluster, configMap)
+	if err != nil {
+		return "", err
+	}
+
+	labels := map[string]string{
+		r.LabelsManager.ClusterName(): cluster.GetName(),
+	}
+	if !cmp.Equal(configMap.GetLabels(), labels) {
+		configMap.SetLabels(labels)
+		changed = true
+	}
+
+	if configMap.Data[utils.ClusterConfigFile] != string(data) {
+		configMap.Data = map[string]string{
+			utils.ClusterConfigFile: string(data),
+		}
+		changed = true
+	}
+
+	if changed {
+		err = saveFunc(ctx, configMap)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return utils.MD5(data), nil
+}
+
+// RenderClusterConfig renders YAML of Tarantool 3.x cluster config. Each role is a group,
+// each StatefulSet of role is a replicaset and each pod is an instance listening on its advertise URI.
+func RenderClusterConfig(cluster api.Cluster, roles []api.Role) ([]byte, error) {
+	tarantool3 := cluster.GetTarantool3Config()
+
+	config := map[string]interface{}{}
+
+	if tarantool3.GetConfig() != "" {
+		err := yaml.Unmarshal([]byte(tarantool3.GetConfig()), &config)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse config of cluster")
+		}
+	}
+
+	generated := map[string]interface{}{
+		"console": map[string]interface{}{
+			"socket": utils.ConsoleSocket,
+		},
+		"replication": clusterConfigReplication(cluster.GetFailoverConfig()),
+	}
+
+	if users := tarantool3.GetUsers(); len(users) > 0 {
+		context := map[string]interface{}{}
+		credentials := map[string]interface{}{}
+
+		for _, user := range users {
+			options := map[string]interface{}{}
+
+			if len(user.GetRoles()) > 0 {
+				options["roles"] = user.GetRoles()
+			}
+
+			if user.GetPasswordSecretKeyRef() != nil {
+				env := utils.PasswordEnvName(user.GetName())
+				context[env] = map[string]interface{}{
+					"from": "env",
+					"env":  env,
+				}
+				options["password"] = fmt.Sprintf("{{ context.%s }}", env)
+			}
+
+			credentials[user.GetName()] = options
+		}
+
+		generated["credentials"] = map[string]interface{}{"users": credentials}
+
+		if len(context) > 0 {
+			generated["config"] = map[string]interface{}{"context": context}
+		}
+	}
+
+	sorted := make([]api.Role, len(roles))
+	copy(sorted, roles)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+
+	hasStorages := false
+	groups := map[string]interface{}{}
+
+	for _, role := range sorted {
+		group, err := renderGroup(cluster, role)
+		if err != nil {
+			return nil, err
+		}
+
+		groups[role.GetName()] = group
+		hasStorages = hasStorages || role.IsStorage()
+	}
+
+	generated["groups"] = groups
+
+	if peerUser := tarantool3.GetPeerUser(); peerUser != "" {
+		advertise := map[string]interface{}{
+			"peer": map[string]interface{}{"login": peerUser},
+		}
+		if hasStorages {
+			advertise["sharding"] = map[string]interface{}{"login": peerUser}
+		}
+
+		generated["iproto"] = map[string]interface{}{"advertise": advertise}
+	}
+
+	mergeClusterConfig(config, generated)
+
+	return yaml.Marshal(config)
+}
+
+func renderGroup(cluster api.Cluster, role api.Role) (map[string]interface{}, error) {
+	manualFailover := clusterConfigFailover(cluster.GetFailoverConfig().GetMode()) == "manual"
+	replicasets := map[string]interface{}{}
+
+	for ordinal := int32(0); ordinal < role.GetReplicasets(); ordinal++ {
+		replicasetName, err := role.GetReplicasetName(ordinal)
+		if err != nil {
+			return nil, err
+		}
+
+		instances := map[string]interface{}{}
+
+		for replica := int32(0); replica < role.GetReplicas(); replica++ {
+			instanceName := fmt.Sprintf("%s-%d", replicasetName, replica)
+			uri := instanceURI(cluster, role.GetNamespace(), instanceName)
+			listen := map[string]interface{}{"uri": uri}
+			if params := clusterConfigSSLParams(cluster.GetTLSConfig()); params != nil {
+				listen["params"] = params
+			}
+
+			instances[instanceName] = map[string]interface{}{
+				"iproto": map[string]interface{}{
+					"listen": []interface{}{listen},
+				},
+			}
+		}
+
+		replicaset := map[string]interface{}{
+			"instances": instances,
+		}
+		if manualFailover {
+			replicaset["leader"] = fmt.Sprintf("%s-0", replicasetName)
+		}
+
+		replicasets[replicasetName] = replicaset
+	}
+
+	group := map[string]interface{}{
+		"replicasets": replicasets,
+	}
+
+	if cluster.GetFailoverConfig().GetMode() == api.FailoverModeRaft {
+		group["replication"] = map[string]interface{}{
+			"election_mode": string(role.GetElectionMode()),
+		}
+	}
+
+	shardingRoles := []string{}
+
+	for _, vshardRole := range role.GetVShardConfig().GetRoles() {
+		if shardingRole, ok := vshardShardingRoles[vshardRole]; ok && !utils.SliceContains(shardingRoles, shardingRole) {
+			shardingRoles = append(shardingRoles, shardingRole)
+		}
+	}
+
+	if len(shardingRoles) > 0 {
+		sort.Strings(shardingRoles)
+		group["sharding"] = map[string]interface{}{"roles": shardingRoles}
+	}
+
+	return group, nil
+}
+
+// clusterConfigEnv returns environment variables with passwords of users referenced by cluster config.
+func clusterConfigEnv(cluster api.Cluster) []v1.EnvVar {
+	var env []v1.EnvVar
+
+	for _, user := range cluster.GetTarantool3Config().GetUsers() {
+		if user.GetPasswordSecretKeyRef() == nil {
+			continue
+		}
+
+		env = append(env, v1.EnvVar{
+			Name: utils.PasswordEnvName(user.GetName()),
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: user.GetPasswordSecretKeyRef(),
+			},
+		})
+	}
+
+	return env
+}
+
+// clusterConfigSSLParams returns params of iproto listen URI which enable SSL transport of Tarantool Enterprise
+// with certificates mounted by utils.MountTLS, it returns nil when TLS is disabled.
+func clusterConfigSSLParams(tls api.TLSConfig) map[string]interface{} {
+	if tls == nil {
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"transport":     "ssl",
+		"ssl_cert_file": path.Join(tls.GetMountPath(), utils.TLSCertKey),
+		"ssl_key_file":  path.Join(tls.GetMountPath(), utils.TLSKeyKey),
+		"ssl_ca_file":   path.Join(tls.GetMountPath(), utils.TLSCAKey),
+	}
+
+	if tls.GetCiphers() != "" {
+		params["ssl_ciphers"] = tls.GetCiphers()
+	}
+
+	return params
+}
+
+// clusterConfigReplication returns replication section of cluster config with failover mode and Raft params.
+func clusterConfigReplication(failover api.FailoverConfig) map[string]interface{} {
+	replication := map[string]interface{}{
+		"failover": clusterConfigFailover(failover.GetMode()),
+	}
+
+	raft := failover.GetRaftConfig()
+	if failover.GetMode() != api.FailoverModeRaft || raft == nil {
+		return replication
+	}
+
+	if timeout := raft.GetElectionTimeout(); timeout > 0 {
+		replication["election_timeout"] = timeout.Seconds()
+	}
+
+	if quorum := raft.GetSynchroQuorum(); quorum != nil {
+		if quorum.Type == intstr.Int {
+			replication["synchro_quorum"] = quorum.IntValue()
+		} else {
+			replication["synchro_quorum"] = quorum.StrVal
+		}
+	}
+
+	return replication
+}
+
+// clusterConfigFailover maps Cartridge failover mode to replication.failover of Tarantool 3.x.
+// Eventual failover has no analogue, so leaders of replicasets are appointed as with disabled failover.
+func clusterConfigFailover(mode api.FailoverMode) string {
+	switch mode {
+	case api.FailoverModeStateful:
+		return "supervised"
+	case api.FailoverModeRaft:
+		return "election"
+	default:
+		return "manual"
+	}
+}
+
+// mergeClusterConfig deeply merges src into dst, values of src take precedence.
+func mergeClusterConfig(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+
+		if srcIsMap && dstIsMap {
+			mergeClusterConfig(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[key] = value
+	}
+}
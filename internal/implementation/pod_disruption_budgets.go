@@ -0,0 +1,103 @@
+package implementation
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func (r *ReplicasetsManger) SyncPodDisruptionBudgets(ctx context.Context, cluster api.Cluster, role *v1beta1.Role) error {
+	stsList, err := r.ListStatefulSets(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
+	if err != nil {
+		return err
+	}
+
+	for key := range stsList.Items {
+		sts := &stsList.Items[key]
+		if sts.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		pdb := &policyv1.PodDisruptionBudget{}
+
+		err = r.Get(ctx, types.NamespacedName{Namespace: sts.GetNamespace(), Name: sts.GetName()}, pdb)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		exists := err == nil
+
+		pdb.SetName(sts.GetName())
+		pdb.SetNamespace(sts.GetNamespace())
+
+		changed := r.syncPodDisruptionBudget(cluster, role, sts.GetName(), pdb)
+
+		controlled, err := r.ControlObject(role, pdb)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case !exists:
+			err = r.CreateObject(ctx, pdb)
+		case changed || controlled:
+			err = r.UpdateObject(ctx, pdb)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ReplicasetsManger) syncPodDisruptionBudget(
+	cluster api.Cluster,
+	role *v1beta1.Role,
+	replicasetName string,
+	pdb *policyv1.PodDisruptionBudget,
+) bool {
+	changed := false
+
+	labels := map[string]string{
+		r.LabelsManager.ClusterName():    cluster.GetName(),
+		r.LabelsManager.RoleName():       role.GetName(),
+		r.LabelsManager.ReplicasetName(): replicasetName,
+	}
+
+	if !cmp.Equal(labels, pdb.GetLabels()) {
+		pdb.SetLabels(labels)
+
+		changed = true
+	}
+
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			r.LabelsManager.ReplicasetName(): replicasetName,
+		},
+	}
+
+	if !cmp.Equal(selector, pdb.Spec.Selector) {
+		pdb.Spec.Selector = selector
+
+		changed = true
+	}
+
+	maxUnavailable := role.GetMaxUnavailable()
+
+	if pdb.Spec.MaxUnavailable == nil || *pdb.Spec.MaxUnavailable != maxUnavailable || pdb.Spec.MinAvailable != nil {
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+		pdb.Spec.MinAvailable = nil
+
+		changed = true
+	}
+
+	return changed
+}
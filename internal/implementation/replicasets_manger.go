@@ -3,6 +3,7 @@ package implementation
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/google/go-cmp/cmp"
@@ -31,18 +32,43 @@ func (r *ReplicasetsManger) GetReplicasetUUID(role *v1beta1.Role, ordinal int32)
 	return replicasetUUID.String()
 }
 
+// GetAdvertiseURI has no SSL parameters even if TLS is enabled, Cartridge adds them from its transport options
+// and membership uses the same URI over UDP.
 func (r *ReplicasetsManger) GetAdvertiseURI(cluster api.Cluster, pod *v1.Pod) string {
+	return instanceURI(cluster, pod.GetObjectMeta().GetNamespace(), pod.GetObjectMeta().GetName())
+}
+
+func (r *ReplicasetsManger) GetReplicationURIs(cluster api.Cluster, sts *appsv1.StatefulSet) []string {
+	var replicas int32 = 1
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	uris := make([]string, 0, replicas)
+	for ordinal := int32(0); ordinal < replicas; ordinal++ {
+		uris = append(uris, instanceURI(cluster, sts.GetNamespace(), fmt.Sprintf("%s-%d", sts.GetName(), ordinal)))
+	}
+
+	return uris
+}
+
+func instanceURI(cluster api.Cluster, namespace, pod string) string {
 	return fmt.Sprintf(
 		"%s.%s.%s.svc.%s:%d",
-		pod.GetObjectMeta().GetName(),      // Instance name
-		cluster.GetName(),                  // Cartridge cluster name
-		pod.GetObjectMeta().GetNamespace(), // Namespace
-		cluster.GetDomain(),                // Cluster domain name
+		pod,                 // Instance name
+		cluster.GetName(),   // Cartridge cluster name
+		namespace,           // Namespace
+		cluster.GetDomain(), // Cluster domain name
 		cluster.GetListenPort(),
 	)
 }
 
 func (r *ReplicasetsManger) CreateStatefulSets(ctx context.Context, cluster api.Cluster, role *v1beta1.Role) error {
+	certificatesHash, err := r.certificatesHash(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
 	for ordinal := int32(0); ordinal < role.GetReplicasets(); ordinal++ {
 		selector := r.LabelsManager.SelectorByReplicasetOrdinal(role, ordinal)
 
@@ -55,7 +81,7 @@ func (r *ReplicasetsManger) CreateStatefulSets(ctx context.Context, cluster api.
 			return err
 		}
 
-		err = r.createStatefulSet(ctx, cluster, role, ordinal)
+		err = r.createStatefulSet(ctx, cluster, role, ordinal, certificatesHash)
 		if err != nil {
 			return err
 		}
@@ -64,28 +90,57 @@ func (r *ReplicasetsManger) CreateStatefulSets(ctx context.Context, cluster api.
 	return nil
 }
 
+// UpdateStatefulSets syncs StatefulSets of role in order of ordinals. Renewal of certificates restarts
+// a single StatefulSet of cluster at a time, the next one is restarted after all pods of the previous one are updated and ready.
 func (r *ReplicasetsManger) UpdateStatefulSets(ctx context.Context, cluster api.Cluster, role *v1beta1.Role) (complete bool, err error) {
 	var (
 		updated bool
-		ordinal int64
 		done    = true
 		stsList *appsv1.StatefulSetList
 	)
 
+	certificatesHash, err := r.certificatesHash(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+
 	stsList, err = r.ListStatefulSets(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
 	if err != nil {
 		return
 	}
 
-	for key := range stsList.Items {
-		sts := &stsList.Items[key]
+	ordinals := make([]int32, len(stsList.Items))
 
-		ordinal, err = strconv.ParseInt(sts.GetLabels()[r.LabelsManager.ReplicasetOrdinal()], 10, 32)
+	for key := range stsList.Items {
+		ordinal, err := strconv.ParseInt(stsList.Items[key].GetLabels()[r.LabelsManager.ReplicasetOrdinal()], 10, 32)
 		if err != nil {
 			return false, err
 		}
 
-		updated, err = r.updateStatefulSet(ctx, cluster, role, sts, int32(ordinal))
+		ordinals[key] = int32(ordinal)
+	}
+
+	sort.Sort(statefulSetsByOrdinal{items: stsList.Items, ordinals: ordinals})
+
+	restarting, err := r.isRestarting(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+
+	for key := range stsList.Items {
+		sts := &stsList.Items[key]
+
+		if r.isCertificatesRenewed(sts, certificatesHash) {
+			if restarting {
+				done = false
+
+				continue
+			}
+
+			restarting = true
+		}
+
+		updated, err = r.updateStatefulSet(ctx, cluster, role, sts, ordinals[key], certificatesHash)
 		if err != nil {
 			return false, err
 		}
@@ -98,7 +153,13 @@ func (r *ReplicasetsManger) UpdateStatefulSets(ctx context.Context, cluster api.
 	return done, nil
 }
 
-func (r *ReplicasetsManger) createStatefulSet(ctx context.Context, cluster api.Cluster, role *v1beta1.Role, ordinal int32) error {
+func (r *ReplicasetsManger) createStatefulSet(
+	ctx context.Context,
+	cluster api.Cluster,
+	role *v1beta1.Role,
+	ordinal int32,
+	certificatesHash string,
+) error {
 	stsName, err := role.GetReplicasetName(ordinal)
 	if err != nil {
 		return err
@@ -136,7 +197,7 @@ func (r *ReplicasetsManger) createStatefulSet(ctx context.Context, cluster api.C
 		},
 	}
 
-	_, err = r.syncStatefulSet(cluster, role, sts, ordinal, replicasetUUID)
+	_, err = r.syncStatefulSet(cluster, role, sts, ordinal, replicasetUUID, certificatesHash)
 	if err != nil {
 		return err
 	}
@@ -144,10 +205,17 @@ func (r *ReplicasetsManger) createStatefulSet(ctx context.Context, cluster api.C
 	return r.CreateObject(ctx, sts)
 }
 
-func (r *ReplicasetsManger) updateStatefulSet(ctx context.Context, cluster api.Cluster, role *v1beta1.Role, sts *appsv1.StatefulSet, ordinal int32) (bool, error) {
+func (r *ReplicasetsManger) updateStatefulSet(
+	ctx context.Context,
+	cluster api.Cluster,
+	role *v1beta1.Role,
+	sts *appsv1.StatefulSet,
+	ordinal int32,
+	certificatesHash string,
+) (bool, error) {
 	replicasetUUID := r.GetReplicasetUUID(role, ordinal)
 
-	changed, err := r.syncStatefulSet(cluster, role, sts, ordinal, replicasetUUID)
+	changed, err := r.syncStatefulSet(cluster, role, sts, ordinal, replicasetUUID, certificatesHash)
 	if err != nil {
 		return false, err
 	}
@@ -168,6 +236,7 @@ func (r *ReplicasetsManger) syncStatefulSet(
 	sts *appsv1.StatefulSet,
 	ordinal int32,
 	replicasetUUID string,
+	certificatesHash string,
 ) (bool, error) {
 	var (
 		changed bool
@@ -175,7 +244,7 @@ func (r *ReplicasetsManger) syncStatefulSet(
 	)
 
 	// Prepare revision hash
-	rsPodTemplateHash, err := utils.HashObject(&role.Spec.ReplicasetTemplate.PodTemplate)
+	rsPodTemplateHash, err := podTemplateHash(cluster, role, certificatesHash)
 	if err != nil {
 		return changed, err
 	}
@@ -194,6 +263,10 @@ func (r *ReplicasetsManger) syncStatefulSet(
 		r.LabelsManager.ReplicasetPodTemplateHash(): rsPodTemplateHash,
 	})
 
+	if certificatesHash != "" {
+		stsLabels[r.LabelsManager.ReplicasetCertificatesHash()] = certificatesHash
+	}
+
 	podTemplateLabels := utils.MergeMaps(
 		role.Spec.ReplicasetTemplate.PodTemplate.GetLabels(),
 		stsLabels,
@@ -245,6 +318,35 @@ func (r *ReplicasetsManger) syncStatefulSet(
 	if rsPodTemplateHash != sts.Labels[r.LabelsManager.ReplicasetPodTemplateHash()] {
 		role.Spec.ReplicasetTemplate.PodTemplate.DeepCopyInto(&sts.Spec.Template)
 
+		if placement := role.Spec.Placement; placement != nil {
+			utils.ApplyPlacement(
+				&sts.Spec.Template.Spec,
+				placement.GetTopologyKey(),
+				placement.IsRequired(),
+				&metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						r.LabelsManager.ReplicasetName(): sts.GetName(),
+					},
+				},
+			)
+		}
+
+		if tls := cluster.GetTLSConfig(); tls != nil {
+			if cluster.GetFlavor() == api.ClusterFlavorTarantool3 {
+				utils.MountTLS(&sts.Spec.Template.Spec, tls.GetSecretName(), tls.GetMountPath())
+			} else {
+				utils.ApplyTLS(&sts.Spec.Template.Spec, tls.GetSecretName(), tls.GetMountPath(), tls.GetCiphers())
+			}
+		}
+
+		if cluster.GetFlavor() == api.ClusterFlavorTarantool3 {
+			utils.ApplyClusterConfig(&sts.Spec.Template.Spec, utils.ClusterConfigMapName(cluster.GetName()), clusterConfigEnv(cluster))
+		}
+
+		if cluster.GetFlavor() == api.ClusterFlavorPlain {
+			utils.ApplyConsoleSocket(&sts.Spec.Template.Spec)
+		}
+
 		changed = true
 	}
 
@@ -273,3 +375,61 @@ func (r *ReplicasetsManger) syncStatefulSet(
 
 	return changed, nil
 }
+
+// podTemplateHash returns hash of pod template of role, placement and TLS of cluster are taken into account
+// only when they are set, so hashes of existing StatefulSets do not change and their pods are not restarted.
+// Hash of certificates makes pods restart with renewed certificates, because Tarantool reads them only on start.
+func podTemplateHash(cluster api.Cluster, role *v1beta1.Role, certificatesHash string) (string, error) {
+	tls := cluster.GetTLSConfig()
+	flavor := cluster.GetFlavor()
+
+	if role.Spec.Placement == nil && tls == nil && flavor == api.ClusterFlavorCartridge {
+		return utils.HashObject(&role.Spec.ReplicasetTemplate.PodTemplate)
+	}
+
+	var configEnv []v1.EnvVar
+
+	if flavor == api.ClusterFlavorTarantool3 {
+		configEnv = clusterConfigEnv(cluster)
+	}
+
+	// Cartridge flavor is omitted to keep hash of existing pod templates
+	if flavor == api.ClusterFlavorCartridge {
+		flavor = ""
+	}
+
+	return utils.HashObject(struct {
+		PodTemplate  *v1.PodTemplateSpec
+		Placement    *v1beta1.RolePlacement
+		TLS          api.TLSConfig     `json:",omitempty"`
+		Certificates string            `json:",omitempty"`
+		Flavor       api.ClusterFlavor `json:",omitempty"`
+		ConfigEnv    []v1.EnvVar       `json:",omitempty"`
+	}{
+		PodTemplate:  &role.Spec.ReplicasetTemplate.PodTemplate,
+		Placement:    role.Spec.Placement,
+		TLS:          tls,
+		Certificates: certificatesHash,
+		Flavor:       flavor,
+		ConfigEnv:    configEnv,
+	})
+}
+
+// statefulSetsByOrdinal sorts StatefulSets of role by ordinals of replicasets.
+type statefulSetsByOrdinal struct {
+	items    []appsv1.StatefulSet
+	ordinals []int32
+}
+
+func (s statefulSetsByOrdinal) Len() int {
+	return len(s.items)
+}
+
+func (s statefulSetsByOrdinal) Less(i, j int) bool {
+	return s.ordinals[i] < s.ordinals[j]
+}
+
+func (s statefulSetsByOrdinal) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.ordinals[i], s.ordinals[j] = s.ordinals[j], s.ordinals[i]
+}
@@ -31,6 +31,22 @@ func (r *ResourcesManager) GetCluster(ctx context.Context, ns, name string) (api
 	return cluster, nil
 }
 
+func (r *ResourcesManager) ListClusters(ctx context.Context) ([]api.Cluster, error) {
+	clusterList := &v1beta1.ClusterList{}
+
+	err := r.List(ctx, clusterList)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Cluster, len(clusterList.Items))
+	for k := range clusterList.Items {
+		result[k] = &clusterList.Items[k]
+	}
+
+	return result, nil
+}
+
 func (r *ResourcesManager) GetClusterRoles(ctx context.Context, cluster api.Cluster) ([]api.Role, error) {
 	selector := r.LabelsManager.SelectorByClusterName(cluster)
 
@@ -48,3 +64,40 @@ func (r *ResourcesManager) GetClusterRoles(ctx context.Context, cluster api.Clus
 
 	return result, nil
 }
+
+func (r *ResourcesManager) GetClusterCartridgeConfigs(ctx context.Context, cluster api.Cluster) ([]api.CartridgeConfig, error) {
+	selector := r.LabelsManager.SelectorByClusterName(cluster)
+
+	configList := &v1beta1.CartridgeConfigList{}
+
+	err := r.List(ctx, configList, &client.ListOptions{LabelSelector: selector, Namespace: cluster.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.CartridgeConfig, len(configList.Items))
+	for k := range configList.Items {
+		result[k] = &configList.Items[k]
+	}
+
+	return result, nil
+}
+
+func (r *ResourcesManager) IsClusterRestoring(ctx context.Context, cluster api.Cluster) (bool, error) {
+	selector := r.LabelsManager.SelectorByClusterName(cluster)
+
+	restoreList := &v1beta1.TarantoolRestoreList{}
+
+	err := r.List(ctx, restoreList, &client.ListOptions{LabelSelector: selector, Namespace: cluster.GetNamespace()})
+	if err != nil {
+		return false, err
+	}
+
+	for k := range restoreList.Items {
+		if !restoreList.Items[k].IsFinished() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
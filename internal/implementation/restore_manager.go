@@ -0,0 +1,319 @@
+package implementation
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type RestoreManager struct {
+	*ResourcesManager
+}
+
+func (r *RestoreManager) GetBackup(ctx context.Context, restore *v1beta1.TarantoolRestore) (*v1beta1.TarantoolBackup, error) {
+	backup := &v1beta1.TarantoolBackup{}
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: restore.GetBackupNamespace(), Name: restore.GetBackupName()}, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+func (r *RestoreManager) PrepareVolumes(
+	ctx context.Context,
+	cluster api.Cluster,
+	restore *v1beta1.TarantoolRestore,
+	backup *v1beta1.TarantoolBackup,
+) error {
+	relocated := r.isRelocated(restore, backup)
+
+	for k := range backup.Status.Instances {
+		instance := &backup.Status.Instances[k]
+		roleName := restore.GetRestoredRoleName(instance.Role)
+		podName := roleName + strings.TrimPrefix(instance.Pod, instance.Role)
+		claimName := fmt.Sprintf("%s-%s", instance.Volume, podName)
+
+		existing := &v1.PersistentVolumeClaim{}
+
+		err := r.Get(ctx, types.NamespacedName{Namespace: restore.GetNamespace(), Name: claimName}, existing)
+		if err == nil {
+			if existing.GetLabels()[r.LabelsManager.RestoreName()] != restore.GetName() {
+				return fmt.Errorf("volume %s already exists and would be overwritten", claimName)
+			}
+
+			r.addRestoreInstance(restore, instance, podName, claimName, relocated)
+
+			continue
+		}
+
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		role := &v1beta1.Role{}
+
+		err = r.Get(ctx, types.NamespacedName{Namespace: restore.GetNamespace(), Name: roleName}, role)
+		if err != nil {
+			return err
+		}
+
+		template := findVolumeClaimTemplate(role, instance.Volume)
+		if template == nil {
+			return fmt.Errorf("role %s has no volume claim template %s", role.GetName(), instance.Volume)
+		}
+
+		claim := &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      claimName,
+				Namespace: restore.GetNamespace(),
+				Labels: utils.MergeMaps(template.GetLabels(), map[string]string{
+					r.LabelsManager.ClusterName(): cluster.GetName(),
+					r.LabelsManager.RoleName():    role.GetName(),
+					r.LabelsManager.RestoreName(): restore.GetName(),
+				}),
+				Annotations: template.GetAnnotations(),
+			},
+			Spec: *template.Spec.DeepCopy(),
+		}
+
+		if instance.VolumeSnapshot != "" {
+			// VolumeSnapshot is namespaced, claims can be provisioned only in the namespace of snapshot
+			if restore.GetBackupNamespace() != restore.GetNamespace() {
+				return fmt.Errorf("volume snapshot %s can not be restored into namespace %s", instance.VolumeSnapshot, restore.GetNamespace())
+			}
+
+			claim.Spec.DataSource = &v1.TypedLocalObjectReference{
+				APIGroup: &utils.VolumeSnapshotGVK.Group,
+				Kind:     utils.VolumeSnapshotGVK.Kind,
+				Name:     instance.VolumeSnapshot,
+			}
+		}
+
+		err = r.CreateObject(ctx, claim)
+		if err != nil {
+			return err
+		}
+
+		r.addRestoreInstance(restore, instance, podName, claimName, relocated)
+	}
+
+	return nil
+}
+
+// addRestoreInstance remembers volume of instance in restore, volumes provisioned from VolumeSnapshot
+// are restored without downloading files unless advertise URIs must be rewritten.
+func (r *RestoreManager) addRestoreInstance(
+	restore *v1beta1.TarantoolRestore,
+	instance *v1beta1.BackupInstance,
+	podName string,
+	claimName string,
+	relocated bool,
+) {
+	restore.AddInstance(podName, instance.Pod, claimName)
+
+	if instance.VolumeSnapshot != "" && !relocated {
+		restore.SetInstanceRestored(podName)
+	}
+}
+
+// isRelocated returns true when backup is restored into another namespace, cluster or roles.
+func (r *RestoreManager) isRelocated(restore *v1beta1.TarantoolRestore, backup *v1beta1.TarantoolBackup) bool {
+	if restore.GetBackupNamespace() != restore.GetNamespace() {
+		return true
+	}
+
+	if r.backupClusterName(restore, backup) != restore.GetLabels()[r.LabelsManager.ClusterName()] {
+		return true
+	}
+
+	for k := range backup.Status.Instances {
+		role := backup.Status.Instances[k].Role
+		if restore.GetRestoredRoleName(role) != role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *RestoreManager) backupClusterName(restore *v1beta1.TarantoolRestore, backup *v1beta1.TarantoolBackup) string {
+	if name := backup.GetLabels()[r.LabelsManager.ClusterName()]; name != "" {
+		return name
+	}
+
+	return restore.GetLabels()[r.LabelsManager.ClusterName()]
+}
+
+// rewriteURIsCommand returns shell command which replaces advertise URIs of backed up instances
+// with URIs of restored instances in clusterwide config stored in working directory of instance.
+// URIs are replaced through placeholder, so roles can be swapped without chained replacements.
+func (r *RestoreManager) rewriteURIsCommand(restore *v1beta1.TarantoolRestore, backup *v1beta1.TarantoolBackup, workdir string) string {
+	roles := map[string]bool{}
+	for k := range backup.Status.Instances {
+		roles[backup.Status.Instances[k].Role] = true
+	}
+
+	for role := range restore.Spec.Roles {
+		roles[role] = true
+	}
+
+	names := make([]string, 0, len(roles))
+	for role := range roles {
+		names = append(names, role)
+	}
+
+	sort.Strings(names)
+
+	const placeholder = "@restored@"
+
+	sourceHost := fmt.Sprintf(".%s.%s.svc.", r.backupClusterName(restore, backup), restore.GetBackupNamespace())
+	targetHost := fmt.Sprintf(".%s.%s.svc.", restore.GetLabels()[r.LabelsManager.ClusterName()], restore.GetNamespace())
+
+	expressions := make([]string, 0, len(names)+1)
+	for _, role := range names {
+		expressions = append(expressions, shellQuote(fmt.Sprintf(
+			`s#(^|[^a-z0-9-])%s-([0-9]+-[0-9]+)%s#\1%s-\2.%s.#g`,
+			role,
+			strings.ReplaceAll(sourceHost, ".", `\.`),
+			restore.GetRestoredRoleName(role),
+			placeholder,
+		)))
+	}
+
+	expressions = append(expressions, shellQuote(fmt.Sprintf(`s#\.%s\.#%s#g`, placeholder, targetHost)))
+
+	return fmt.Sprintf(
+		"find %s -name '*.yml' -exec sed -E -i -e %s {} +",
+		shellQuote(workdir),
+		strings.Join(expressions, " -e "),
+	)
+}
+
+func (r *RestoreManager) EnsureDownloadJob(
+	ctx context.Context,
+	restore *v1beta1.TarantoolRestore,
+	backup *v1beta1.TarantoolBackup,
+	podName string,
+) (*batchv1.Job, error) {
+	jobName := storageJobName(restore.GetName(), podName)
+
+	job := &batchv1.Job{}
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: restore.GetNamespace(), Name: jobName}, job)
+	if err == nil {
+		return job, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	backupPod := restore.GetBackupPod(podName)
+
+	instance := backup.GetInstance(backupPod)
+	if instance == nil {
+		return nil, fmt.Errorf("instance %s is not recorded in backup %s", backupPod, backup.GetName())
+	}
+
+	role := &v1beta1.Role{}
+
+	err = r.Get(ctx, types.NamespacedName{Namespace: restore.GetNamespace(), Name: restore.GetRestoredRoleName(instance.Role)}, role)
+	if err != nil {
+		return nil, err
+	}
+
+	storage := &backup.Spec.Storage
+
+	var script []string
+	if instance.VolumeSnapshot == "" {
+		script = append(script, storageDownloadCommand(storage, path.Join(backup.Status.Location, backupPod), instance.Workdir))
+	}
+
+	var rewrite []string
+	if r.isRelocated(restore, backup) {
+		rewrite = append(rewrite, r.rewriteURIsCommand(restore, backup, instance.Workdir))
+	}
+
+	volumes := []v1.Volume{
+		{
+			Name: dataVolumeName,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: fmt.Sprintf("%s-%s", instance.Volume, podName),
+				},
+			},
+		},
+	}
+	mounts := []v1.VolumeMount{
+		{
+			Name:      dataVolumeName,
+			MountPath: instance.MountPath,
+		},
+	}
+
+	job = newStorageJob(metav1.ObjectMeta{
+		Name:      jobName,
+		Namespace: restore.GetNamespace(),
+		Labels: map[string]string{
+			r.LabelsManager.ClusterName(): restore.GetLabels()[r.LabelsManager.ClusterName()],
+			r.LabelsManager.RestoreName(): restore.GetName(),
+		},
+	}, storage, script, volumes, mounts)
+
+	// Files must be owned by the same user as tarantool runs with
+	job.Spec.Template.Spec.SecurityContext = role.Spec.ReplicasetTemplate.PodTemplate.Spec.SecurityContext
+
+	if len(rewrite) > 0 {
+		addRewriteContainer(job, script, rewrite, mounts)
+	}
+
+	_, err = r.ControlObject(restore, job)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CreateObject(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// addRewriteContainer rewrites config after files are downloaded by storage container, image of storage
+// may have no sed, so config is rewritten in a separate container.
+func addRewriteContainer(job *batchv1.Job, script []string, rewrite []string, mounts []v1.VolumeMount) {
+	spec := &job.Spec.Template.Spec
+	container := v1.Container{
+		Name:         "rewrite-config",
+		Image:        DefaultPVCStorageImage,
+		Command:      []string{"/bin/sh", "-ec", strings.Join(rewrite, "\n")},
+		VolumeMounts: mounts,
+	}
+
+	if len(script) == 0 {
+		spec.Containers = []v1.Container{container}
+
+		return
+	}
+
+	spec.InitContainers = spec.Containers
+	spec.Containers = []v1.Container{container}
+}
+
+func findVolumeClaimTemplate(role *v1beta1.Role, name string) *v1.PersistentVolumeClaim {
+	return findClaimTemplate(role.Spec.ReplicasetTemplate.VolumeClaimTemplates, name)
+}
@@ -0,0 +1,95 @@
+package implementation
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (r *ReplicasetsManger) ReportScale(ctx context.Context, role *v1beta1.Role) error {
+	selector := r.LabelsManager.SelectorByRoleName(role)
+
+	stsList, err := r.ListStatefulSets(ctx, role.GetNamespace(), selector)
+	if err != nil {
+		return err
+	}
+
+	replicasets := int32(0)
+
+	for key := range stsList.Items {
+		if stsList.Items[key].GetDeletionTimestamp() == nil {
+			replicasets++
+		}
+	}
+
+	role.SetScaleStatus(replicasets, selector.String())
+
+	return nil
+}
+
+func (r *ReplicasetsManger) GetRemovedReplicasets(ctx context.Context, role *v1beta1.Role) ([]appsv1.StatefulSet, error) {
+	stsList, err := r.ListStatefulSets(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []appsv1.StatefulSet
+
+	for _, sts := range stsList.Items {
+		if sts.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		ordinal, err := strconv.ParseInt(sts.GetLabels()[r.LabelsManager.ReplicasetOrdinal()], 10, 32)
+		if err != nil {
+			continue
+		}
+
+		if int32(ordinal) >= role.GetReplicasets() {
+			removed = append(removed, sts)
+		}
+	}
+
+	return removed, nil
+}
+
+func (r *ReplicasetsManger) RemoveReplicaset(ctx context.Context, role *v1beta1.Role, sts *appsv1.StatefulSet) error {
+	pdb := &policyv1.PodDisruptionBudget{}
+	pdb.SetName(sts.GetName())
+	pdb.SetNamespace(sts.GetNamespace())
+
+	err := r.Delete(ctx, pdb)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// Instances of replicaset are expelled, their data can not be used to join the cluster again,
+	// so claims are deleted to let replicaset with the same ordinal to start from scratch.
+	for _, template := range sts.Spec.VolumeClaimTemplates {
+		for ordinal := int32(0); ordinal < *sts.Spec.Replicas; ordinal++ {
+			claim := &v1.PersistentVolumeClaim{}
+			claim.SetName(template.GetName() + "-" + utils.GetStatefulSetPodName(sts.GetName(), ordinal))
+			claim.SetNamespace(role.GetNamespace())
+
+			err = r.Delete(ctx, claim)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	err = r.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package implementation
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (r *ReplicasetsManger) SyncServices(ctx context.Context, cluster api.Cluster, role *v1beta1.Role) error {
+	services := map[string]map[string]string{
+		role.GetName():         nil,
+		role.GetName() + "-rw": {r.LabelsManager.ReplicasetMaster(): "true"},
+		role.GetName() + "-ro": {r.LabelsManager.ReplicasetMaster(): "false"},
+	}
+
+	for name, selector := range services {
+		enabled := role.Spec.Service != nil && (selector == nil || role.IsStorage())
+
+		err := r.syncService(ctx, cluster, role, name, selector, enabled)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncService creates or updates client Service selecting pods of role with extra selector, deletes it if it is disabled.
+func (r *ReplicasetsManger) syncService(
+	ctx context.Context,
+	cluster api.Cluster,
+	role *v1beta1.Role,
+	name string,
+	selector map[string]string,
+	enabled bool,
+) error {
+	svc := &v1.Service{}
+
+	err := r.Get(ctx, types.NamespacedName{Namespace: role.GetNamespace(), Name: name}, svc)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	exists := err == nil
+
+	if !enabled {
+		if exists && metav1.IsControlledBy(svc, role) {
+			err = r.Delete(ctx, svc)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	svc.SetName(name)
+	svc.SetNamespace(role.GetNamespace())
+
+	changed, err := r.ControlObject(role, svc)
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]string{
+		r.LabelsManager.ClusterName(): cluster.GetName(),
+		r.LabelsManager.RoleName():    role.GetName(),
+	}
+	if !cmp.Equal(labels, svc.GetLabels()) {
+		svc.SetLabels(labels)
+
+		changed = true
+	}
+
+	annotations := r.serviceAnnotations(svc.GetAnnotations(), role.Spec.Service.Annotations)
+	if !cmp.Equal(annotations, utils.MergeMaps(svc.GetAnnotations())) {
+		svc.SetAnnotations(annotations)
+
+		changed = true
+	}
+
+	serviceType := role.Spec.Service.GetType()
+	if svc.Spec.Type != serviceType {
+		svc.Spec.Type = serviceType
+
+		changed = true
+	}
+
+	podSelector := utils.MergeMaps(labels, selector)
+	if !cmp.Equal(podSelector, svc.Spec.Selector) {
+		svc.Spec.Selector = podSelector
+
+		changed = true
+	}
+
+	port := v1.ServicePort{
+		Name:       "app",
+		Port:       cluster.GetListenPort(),
+		TargetPort: intstr.FromInt(int(cluster.GetListenPort())),
+		Protocol:   v1.ProtocolTCP,
+	}
+	// Node port is allocated by Kubernetes and must be kept on update
+	if len(svc.Spec.Ports) == 1 && serviceType != v1.ServiceTypeClusterIP {
+		port.NodePort = svc.Spec.Ports[0].NodePort
+	}
+
+	if !cmp.Equal([]v1.ServicePort{port}, svc.Spec.Ports) {
+		svc.Spec.Ports = []v1.ServicePort{port}
+
+		changed = true
+	}
+
+	switch {
+	case !exists:
+		return r.CreateObject(ctx, svc)
+	case changed:
+		return r.UpdateObject(ctx, svc)
+	}
+
+	return nil
+}
+
+// serviceAnnotations merges annotations of spec into actual annotations of Service and removes annotations
+// which were set by operator before but are removed from spec, keys of spec are recorded in managed annotation.
+func (r *ReplicasetsManger) serviceAnnotations(actual, desired map[string]string) map[string]string {
+	annotations := utils.MergeMaps(actual)
+	managed := r.LabelsManager.ManagedAnnotations()
+
+	for _, key := range strings.Split(annotations[managed], ",") {
+		if _, ok := desired[key]; !ok {
+			delete(annotations, key)
+		}
+	}
+
+	delete(annotations, managed)
+
+	if len(desired) == 0 {
+		return annotations
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	annotations = utils.MergeMaps(annotations, desired)
+	annotations[managed] = strings.Join(keys, ",")
+
+	return annotations
+}
+
+func (r *ReplicasetsManger) SetMasterLabels(ctx context.Context, role *v1beta1.Role, masters map[string]bool) error {
+	pods, err := r.ListPods(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
+	if err != nil {
+		return err
+	}
+
+	for key := range pods.Items {
+		pod := &pods.Items[key]
+		if utils.IsPodDeleting(pod) {
+			continue
+		}
+
+		master := strconv.FormatBool(masters[pod.GetName()])
+		if pod.GetLabels()[r.LabelsManager.ReplicasetMaster()] == master {
+			continue
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		pod.SetLabels(utils.MergeMaps(pod.GetLabels(), map[string]string{
+			r.LabelsManager.ReplicasetMaster(): master,
+		}))
+
+		err = r.Patch(ctx, pod, patch)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
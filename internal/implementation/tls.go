@@ -0,0 +1,86 @@
+package implementation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (r *ReplicasetsManger) ValidateTLSSecret(ctx context.Context, cluster api.Cluster) error {
+	tls := cluster.GetTLSConfig()
+	if tls == nil {
+		return nil
+	}
+
+	secret, err := r.GetSecret(ctx, cluster.GetNamespace(), tls.GetSecretName())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Wrapf(k8s.ErrInvalidTLSSecret, "secret %s not found", tls.GetSecretName())
+		}
+
+		return err
+	}
+
+	for _, key := range []string{utils.TLSCertKey, utils.TLSKeyKey, utils.TLSCAKey} {
+		if len(secret.Data[key]) == 0 {
+			return errors.Wrapf(k8s.ErrInvalidTLSSecret, "secret %s has no %s key", secret.GetName(), key)
+		}
+	}
+
+	return nil
+}
+
+// certificatesHash returns hash of data of TLS Secret of cluster or empty string if TLS is disabled or Secret is absent.
+func (r *ReplicasetsManger) certificatesHash(ctx context.Context, cluster api.Cluster) (string, error) {
+	tls := cluster.GetTLSConfig()
+	if tls == nil {
+		return "", nil
+	}
+
+	secret, err := r.GetSecret(ctx, cluster.GetNamespace(), tls.GetSecretName())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return utils.HashObject(secret.Data)
+}
+
+// isCertificatesRenewed reports whether StatefulSet mounts certificates which differ from the actual ones.
+func (r *ReplicasetsManger) isCertificatesRenewed(sts *appsv1.StatefulSet, certificatesHash string) bool {
+	actual, ok := sts.GetLabels()[r.LabelsManager.ReplicasetCertificatesHash()]
+
+	return ok && certificatesHash != "" && actual != certificatesHash
+}
+
+// isRestarting reports whether pods of any StatefulSet of cluster are being updated or are not ready yet.
+// StatefulSets with OnDelete update strategy are not taken into account, their pods are restarted by users.
+func (r *ReplicasetsManger) isRestarting(ctx context.Context, cluster api.Cluster) (bool, error) {
+	stsList, err := r.ListStatefulSets(ctx, cluster.GetNamespace(), r.LabelsManager.SelectorByClusterName(cluster))
+	if err != nil {
+		return false, err
+	}
+
+	for _, sts := range stsList.Items {
+		if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			continue
+		}
+
+		status := sts.Status
+		if status.ObservedGeneration < sts.GetGeneration() ||
+			status.UpdateRevision != status.CurrentRevision ||
+			status.ReadyReplicas != status.Replicas {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,179 @@
+package implementation
+
+import (
+	"context"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (r *ReplicasetsManger) ExpandVolumeClaims(ctx context.Context, role *v1beta1.Role) (*k8s.VolumeExpansion, error) {
+	expansion := &k8s.VolumeExpansion{}
+
+	stsList, err := r.ListStatefulSets(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range stsList.Items {
+		sts := &stsList.Items[key]
+		if sts.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		outdated := false
+		expandable := true
+
+		for _, template := range role.GetVolumeClaimTemplates() {
+			requested, ok := template.Spec.Resources.Requests[v1.ResourceStorage]
+			if !ok {
+				continue
+			}
+
+			current := findClaimTemplate(sts.Spec.VolumeClaimTemplates, template.GetName())
+			if current == nil {
+				continue
+			}
+
+			if requested.Cmp(current.Spec.Resources.Requests[v1.ResourceStorage]) > 0 {
+				outdated = true
+			}
+
+			for ordinal := int32(0); ordinal < *sts.Spec.Replicas; ordinal++ {
+				claimName := template.GetName() + "-" + utils.GetStatefulSetPodName(sts.GetName(), ordinal)
+
+				claim := &v1.PersistentVolumeClaim{}
+
+				err = r.Get(ctx, types.NamespacedName{Namespace: role.GetNamespace(), Name: claimName}, claim)
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return nil, err
+				}
+
+				ok, err = r.expandVolumeClaim(ctx, role, claim, requested)
+				if err != nil {
+					return nil, err
+				}
+
+				if !ok {
+					expandable = false
+
+					expansion.NotExpandable = append(expansion.NotExpandable, claimName)
+				}
+			}
+		}
+
+		// Volume claim templates of StatefulSet are immutable, so StatefulSet is deleted leaving pods orphaned
+		// and is created again with templates of role as soon as resize of all its claims is requested,
+		// resize itself goes on afterwards. Template stays outdated while any claim can not be expanded.
+		if outdated && expandable {
+			err = r.Delete(ctx, sts, client.PropagationPolicy(metav1.DeletePropagationOrphan))
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+
+			expansion.Recreated = append(expansion.Recreated, sts.GetName())
+		}
+	}
+
+	return expansion, nil
+}
+
+// expandVolumeClaim requests claim to be resized and reports resize progress in role status,
+// returns false if StorageClass of claim does not allow expansion.
+func (r *ReplicasetsManger) expandVolumeClaim(
+	ctx context.Context,
+	role *v1beta1.Role,
+	claim *v1.PersistentVolumeClaim,
+	requested resource.Quantity,
+) (bool, error) {
+	capacity := claim.Status.Capacity[v1.ResourceStorage]
+
+	if requested.Cmp(claim.Spec.Resources.Requests[v1.ResourceStorage]) > 0 {
+		allowed, err := r.isExpansionAllowed(ctx, claim)
+		if err != nil {
+			return false, err
+		}
+
+		if !allowed {
+			role.SetVolumeStatus(v1beta1.RoleVolumeStatus{
+				Name:      claim.GetName(),
+				Requested: requested,
+				Capacity:  capacity,
+				State:     v1beta1.RoleVolumeNotExpandable,
+			})
+
+			return false, nil
+		}
+
+		claim.Spec.Resources.Requests = utils.MergeMaps(claim.Spec.Resources.Requests, v1.ResourceList{
+			v1.ResourceStorage: requested,
+		})
+
+		err = r.UpdateObject(ctx, claim)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if capacity.Cmp(claim.Spec.Resources.Requests[v1.ResourceStorage]) >= 0 {
+		return true, nil
+	}
+
+	state := v1beta1.RoleVolumeResizing
+
+	for _, condition := range claim.Status.Conditions {
+		if condition.Type == v1.PersistentVolumeClaimFileSystemResizePending && condition.Status == v1.ConditionTrue {
+			state = v1beta1.RoleVolumeFileSystemResizePending
+		}
+	}
+
+	role.SetVolumeStatus(v1beta1.RoleVolumeStatus{
+		Name:      claim.GetName(),
+		Requested: claim.Spec.Resources.Requests[v1.ResourceStorage],
+		Capacity:  capacity,
+		State:     state,
+	})
+
+	return true, nil
+}
+
+func (r *ReplicasetsManger) isExpansionAllowed(ctx context.Context, claim *v1.PersistentVolumeClaim) (bool, error) {
+	if claim.Spec.StorageClassName == nil || *claim.Spec.StorageClassName == "" {
+		return false, nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+
+	err := r.Get(ctx, types.NamespacedName{Name: *claim.Spec.StorageClassName}, storageClass)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
+func findClaimTemplate(templates []v1.PersistentVolumeClaim, name string) *v1.PersistentVolumeClaim {
+	for k := range templates {
+		if templates[k].GetName() == name {
+			return &templates[k]
+		}
+	}
+
+	return nil
+}
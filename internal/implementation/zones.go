@@ -0,0 +1,87 @@
+package implementation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func (r *ReplicasetsManger) ReportZones(ctx context.Context, role *v1beta1.Role) (map[string]string, error) {
+	pods, err := r.ListPods(ctx, role.GetNamespace(), r.LabelsManager.SelectorByRoleName(role))
+	if err != nil {
+		return nil, err
+	}
+
+	nodeZones := map[string]string{}
+	replicasetZones := map[string]map[string]int{}
+
+	for key := range pods.Items {
+		pod := &pods.Items[key]
+		replicaset := pod.GetLabels()[r.LabelsManager.ReplicasetName()]
+
+		zone, err := r.getNodeZone(ctx, nodeZones, pod.Spec.NodeName)
+		if err != nil {
+			return nil, err
+		}
+
+		role.SetInstanceStatus(v1beta1.RoleInstanceStatus{
+			Pod:        pod.GetName(),
+			Replicaset: replicaset,
+			Node:       pod.Spec.NodeName,
+			Zone:       zone,
+		})
+
+		if replicasetZones[replicaset] == nil {
+			replicasetZones[replicaset] = map[string]int{}
+		}
+
+		replicasetZones[replicaset][zone]++
+	}
+
+	singleZone := map[string]string{}
+
+	for replicaset, zones := range replicasetZones {
+		if len(zones) != 1 {
+			continue
+		}
+
+		// Replicasets with a single replica and pods on nodes without zone label are not reported
+		for zone, replicas := range zones {
+			if zone != "" && replicas > 1 {
+				singleZone[replicaset] = zone
+			}
+		}
+	}
+
+	sort.Slice(role.Status.Instances, func(i, j int) bool {
+		return role.Status.Instances[i].Pod < role.Status.Instances[j].Pod
+	})
+
+	return singleZone, nil
+}
+
+// getNodeZone returns zone label of node, zones of already seen nodes are taken from cache.
+func (r *ReplicasetsManger) getNodeZone(ctx context.Context, cache map[string]string, nodeName string) (string, error) {
+	if nodeName == "" {
+		return "", nil
+	}
+
+	if zone, ok := cache[nodeName]; ok {
+		return zone, nil
+	}
+
+	node := &v1.Node{}
+
+	err := r.Get(ctx, types.NamespacedName{Name: nodeName}, node)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+
+	cache[nodeName] = node.GetLabels()[v1.LabelTopologyZone]
+
+	return cache[nodeName], nil
+}
@@ -0,0 +1,46 @@
+package steps
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal/context"
+	. "github.com/tarantool/tarantool-operator/internal/controller"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/backup"
+)
+
+func SkipFinishedBackup() *backup.SkipFinishedStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.SkipFinishedStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{}
+}
+
+func SetBackupPhase(phase v1beta1.TarantoolBackupPhase) *backup.SetPhaseStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.SetPhaseStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{
+		Phase: phase,
+	}
+}
+
+func CheckBackupFlavor() *backup.CheckFlavorStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.CheckFlavorStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{
+		FailedPhase: v1beta1.TarantoolBackupFailed,
+	}
+}
+
+func MakeSnapshots() *backup.SnapshotStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.SnapshotStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{
+		FailedPhase: v1beta1.TarantoolBackupFailed,
+	}
+}
+
+func SnapshotBackupVolumes() *backup.SnapshotVolumesStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.SnapshotVolumesStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{
+		FailedPhase: v1beta1.TarantoolBackupFailed,
+	}
+}
+
+func UploadBackupFiles() *backup.UploadStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.UploadStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{
+		FailedPhase: v1beta1.TarantoolBackupFailed,
+	}
+}
+
+func FinishBackup() *backup.FinishStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController] {
+	return &backup.FinishStep[v1beta1.TarantoolBackupPhase, *v1beta1.TarantoolBackup, *BackupContext, *BackupController]{}
+}
@@ -0,0 +1,44 @@
+package steps
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal/context"
+	. "github.com/tarantool/tarantool-operator/internal/controller"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/backuppolicy"
+)
+
+func ForgetDeletedBackupPolicy() *backuppolicy.ForgetDeletedStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.ForgetDeletedStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{
+		Target: &v1beta1.TarantoolBackupPolicy{},
+	}
+}
+
+func SetBackupPolicyPhase(phase v1beta1.TarantoolBackupPolicyPhase) *backuppolicy.SetPhaseStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.SetPhaseStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{
+		Phase: phase,
+	}
+}
+
+func ParseBackupSchedule() *backuppolicy.ParseScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.ParseScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{
+		InvalidPhase: v1beta1.TarantoolBackupPolicyInvalid,
+	}
+}
+
+func SyncPolicyBackups() *backuppolicy.SyncBackupsStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.SyncBackupsStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{}
+}
+
+func ScheduleBackup() *backuppolicy.ScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.ScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{
+		SuspendedPhase: v1beta1.TarantoolBackupPolicySuspended,
+	}
+}
+
+func PruneBackups() *backuppolicy.PruneStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.PruneStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{}
+}
+
+func WaitNextBackupSchedule() *backuppolicy.WaitNextScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController] {
+	return &backuppolicy.WaitNextScheduleStep[v1beta1.TarantoolBackupPolicyPhase, *v1beta1.TarantoolBackupPolicy, *v1beta1.TarantoolBackup, *BackupPolicyContext, *BackupPolicyController]{}
+}
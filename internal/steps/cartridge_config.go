@@ -17,6 +17,23 @@ func SetCartridgeConfigPhase(phase v1beta1.CartridgeConfigPhase) *cartridge.SetP
 	}
 }
 
-func ConfigureCartridge() *cartridge.ConfigureStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController] {
-	return &cartridge.ConfigureStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController]{}
+func ConfigureCartridge() *cartridge.ConfigureStep[v1beta1.CartridgeConfigPhase, *v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController] {
+	return &cartridge.ConfigureStep[v1beta1.CartridgeConfigPhase, *v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController]{
+		DriftedPhase: v1beta1.CartridgeConfigDrifted,
+		InvalidPhase: v1beta1.CartridgeConfigInvalid,
+	}
+}
+
+func LoadDesiredCartridgeConfig() *cartridge.LoadDesiredConfigStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController] {
+	return &cartridge.LoadDesiredConfigStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController]{}
+}
+
+func ResolveCartridgeConfigOwnership() *cartridge.ResolveOwnershipStep[v1beta1.CartridgeConfigPhase, *v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController] {
+	return &cartridge.ResolveOwnershipStep[v1beta1.CartridgeConfigPhase, *v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController]{
+		ConflictPhase: v1beta1.CartridgeConfigConflict,
+	}
+}
+
+func ScheduleCartridgeConfigDriftCheck() *cartridge.ScheduleDriftCheckStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController] {
+	return &cartridge.ScheduleDriftCheckStep[*v1beta1.CartridgeConfig, *CartridgeConfigContext, *CartridgeConfigController]{}
 }
@@ -0,0 +1,25 @@
+package steps
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal/context"
+	. "github.com/tarantool/tarantool-operator/internal/controller"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/schema"
+)
+
+func ResetCartridgeSchemaStatus() *schema.ResetStatusStep[*v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController] {
+	return &schema.ResetStatusStep[*v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController]{}
+}
+
+func SetCartridgeSchemaPhase(phase v1beta1.CartridgeSchemaPhase) *schema.SetPhaseStep[v1beta1.CartridgeSchemaPhase, *v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController] {
+	return &schema.SetPhaseStep[v1beta1.CartridgeSchemaPhase, *v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController]{
+		Phase: phase,
+	}
+}
+
+func ApplySchema() *schema.ApplyStep[v1beta1.CartridgeSchemaPhase, *v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController] {
+	return &schema.ApplyStep[v1beta1.CartridgeSchemaPhase, *v1beta1.CartridgeSchema, *CartridgeSchemaContext, *CartridgeSchemaController]{
+		InvalidPhase:     v1beta1.CartridgeSchemaInvalid,
+		DestructivePhase: v1beta1.CartridgeSchemaDestructive,
+	}
+}
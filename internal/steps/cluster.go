@@ -25,6 +25,14 @@ func SyncClusterWideService() *cluster.SyncClusterWideServiceStep[*Cluster, *Clu
 	return &cluster.SyncClusterWideServiceStep[*Cluster, *ClusterContext, *ClusterController]{}
 }
 
+func SyncMetrics() *cluster.SyncMetricsStep[*Cluster, *ClusterContext, *ClusterController] {
+	return &cluster.SyncMetricsStep[*Cluster, *ClusterContext, *ClusterController]{}
+}
+
+func LabelLeader() *cluster.LabelLeaderStep[*Cluster, *ClusterContext, *ClusterController] {
+	return &cluster.LabelLeaderStep[*Cluster, *ClusterContext, *ClusterController]{}
+}
+
 func WaitForRolesPhases(expectedPhases ...RolePhase) *cluster.WaitForRolesPhaseStep[RolePhase, *Cluster, *ClusterContext, *ClusterController] {
 	return &cluster.WaitForRolesPhaseStep[RolePhase, *Cluster, *ClusterContext, *ClusterController]{
 		ExpectedPhases: expectedPhases,
@@ -44,3 +52,11 @@ func Bootstrap(params BootstrapParams) *cluster.BootstrapStep[ClusterPhase, *Clu
 func ConfigureFailover() *cluster.ConfigureFailoverStep[*Cluster, *ClusterContext, *ClusterController] {
 	return &cluster.ConfigureFailoverStep[*Cluster, *ClusterContext, *ClusterController]{}
 }
+
+func SyncClusterConfig() *cluster.SyncClusterConfigStep[*Cluster, *ClusterContext, *ClusterController] {
+	return &cluster.SyncClusterConfigStep[*Cluster, *ClusterContext, *ClusterController]{}
+}
+
+func BootstrapTarantool3() *cluster.BootstrapTarantool3Step[*Cluster, *ClusterContext, *ClusterController] {
+	return &cluster.BootstrapTarantool3Step[*Cluster, *ClusterContext, *ClusterController]{}
+}
@@ -0,0 +1,40 @@
+package steps
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	. "github.com/tarantool/tarantool-operator/internal/context"
+	. "github.com/tarantool/tarantool-operator/internal/controller"
+	"github.com/tarantool/tarantool-operator/pkg/reconciliation/steps/restore"
+)
+
+func SkipFinishedRestore() *restore.SkipFinishedStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.SkipFinishedStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{}
+}
+
+func SetRestorePhase(phase v1beta1.TarantoolRestorePhase) *restore.SetPhaseStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.SetPhaseStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{
+		Phase: phase,
+	}
+}
+
+func CheckClusterNotBootstrapped() *restore.CheckClusterStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.CheckClusterStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{
+		FailedPhase: v1beta1.TarantoolRestoreFailed,
+	}
+}
+
+func GetRestoreBackup() *restore.GetBackupStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.GetBackupStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{
+		FailedPhase: v1beta1.TarantoolRestoreFailed,
+	}
+}
+
+func PrepareRestoreVolumes() *restore.PrepareVolumesStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.PrepareVolumesStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{}
+}
+
+func DownloadBackupFiles() *restore.DownloadStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController] {
+	return &restore.DownloadStep[v1beta1.TarantoolRestorePhase, *v1beta1.TarantoolRestore, *v1beta1.TarantoolBackup, *RestoreContext, *RestoreController]{
+		FailedPhase: v1beta1.TarantoolRestoreFailed,
+	}
+}
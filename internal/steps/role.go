@@ -16,6 +16,14 @@ func SetRolePhase(phase RolePhase) *role.SetPhaseStep[RolePhase, *Role, *RoleCon
 	}
 }
 
+func SyncCertificate() *role.SyncCertificateStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.SyncCertificateStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ValidateTLSSecret() *role.ValidateTLSSecretStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ValidateTLSSecretStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
 func CreateStatefulSets() *role.CreateStatefulSetsStep[*Role, *RoleContextCE, *RoleControllerCE] {
 	return &role.CreateStatefulSetsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
 }
@@ -24,6 +32,42 @@ func UpdateStatefulSets() *role.UpdateStatefulSetsStep[*Role, *RoleContextCE, *R
 	return &role.UpdateStatefulSetsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
 }
 
+func SyncPodDisruptionBudgets() *role.SyncPodDisruptionBudgetsStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.SyncPodDisruptionBudgetsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ReportZones() *role.ReportZonesStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ReportZonesStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ReportScale() *role.ReportScaleStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ReportScaleStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ScaleDownReplicasets() *role.ScaleDownReplicasetsStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ScaleDownReplicasetsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func CheckStorageUsage() *role.CheckStorageUsageStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.CheckStorageUsageStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func SyncServices() *role.SyncServicesStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.SyncServicesStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func LabelMasters() *role.LabelMastersStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.LabelMastersStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func SetFailoverPriority() *role.SetFailoverPriorityStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.SetFailoverPriorityStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ExpandVolumeClaims() *role.ExpandVolumeClaimsStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ExpandVolumeClaimsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
 func EnsureCartridgeReady() *role.EnsureCartridgeReadyStep[*Role, *RoleContextCE, *RoleControllerCE] {
 	return &role.EnsureCartridgeReadyStep[*Role, *RoleContextCE, *RoleControllerCE]{}
 }
@@ -50,3 +94,15 @@ func ConfigureVShardRoles() *role.ConfigureVShardRolesStep[*Role, *RoleContextCE
 func SetVShardWeights() *role.SetVShardWeightsStep[*Role, *RoleContextCE, *RoleControllerCE] {
 	return &role.SetVShardWeightsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
 }
+
+func ConfigureReplication() *role.ConfigureReplicationStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ConfigureReplicationStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func ConfigureElection() *role.ConfigureElectionStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.ConfigureElectionStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
+
+func SetReplicationParams() *role.SetReplicationParamsStep[*Role, *RoleContextCE, *RoleControllerCE] {
+	return &role.SetReplicationParamsStep[*Role, *RoleContextCE, *RoleControllerCE]{}
+}
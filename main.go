@@ -3,9 +3,12 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	"github.com/tarantool/tarantool-operator/apis/v1beta1"
 	"github.com/tarantool/tarantool-operator/controllers"
+	"github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/watcher"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -30,9 +33,10 @@ func init() {
 
 func main() {
 	var (
-		metricsAddr          string
-		enableLeaderElection bool
-		probeAddr            string
+		metricsAddr           string
+		enableLeaderElection  bool
+		probeAddr             string
+		topologyWatchInterval time.Duration
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -40,6 +44,13 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&topologyWatchInterval, "topology-watch-interval", watcher.DefaultInterval,
+		"How often topology state of bootstrapped clusters is polled to react on failover. "+
+			"Zero disables the watcher.")
+	flag.StringVar(&implementation.DefaultPVCStorageImage, "backup-pvc-image", implementation.DefaultPVCStorageImage,
+		"Image of jobs which copy backup files to PVC storage when backup storage has no image.")
+	flag.StringVar(&implementation.DefaultS3StorageImage, "backup-s3-image", implementation.DefaultS3StorageImage,
+		"Image of jobs which copy backup files to S3 storage when backup storage has no image.")
 
 	opts := zap.Options{
 		Development: true,
@@ -75,12 +86,28 @@ func main() {
 	}
 
 	clusterReconciler := controllers.NewClusterReconciler(mgr)
+	roleReconciler := controllers.NewRoleReconciler(mgr)
+
+	if topologyWatchInterval > 0 {
+		topologyWatcher := watcher.NewTopologyWatcher(
+			clusterReconciler.Controller.GetResourcesManager(),
+			clusterReconciler.Controller.GetMembership(),
+			topologyWatchInterval,
+		)
+		clusterReconciler.TopologyEvents = topologyWatcher.ClusterEvents
+		roleReconciler.TopologyEvents = topologyWatcher.RoleEvents
+
+		if err = mgr.Add(topologyWatcher); err != nil {
+			setupLog.Error(err, "unable to set up topology watcher")
+			os.Exit(1)
+		}
+	}
+
 	if err = clusterReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
 		os.Exit(1)
 	}
 
-	roleReconciler := controllers.NewRoleReconciler(mgr)
 	if err = roleReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Role")
 		os.Exit(1)
@@ -92,6 +119,30 @@ func main() {
 		os.Exit(1)
 	}
 
+	cartridgeSchemaReconciler := controllers.NewCartridgeSchemaReconciler(mgr)
+	if err = cartridgeSchemaReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CartridgeSchema")
+		os.Exit(1)
+	}
+
+	tarantoolBackupReconciler := controllers.NewTarantoolBackupReconciler(mgr)
+	if err = tarantoolBackupReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TarantoolBackup")
+		os.Exit(1)
+	}
+
+	tarantoolRestoreReconciler := controllers.NewTarantoolRestoreReconciler(mgr)
+	if err = tarantoolRestoreReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TarantoolRestore")
+		os.Exit(1)
+	}
+
+	tarantoolBackupPolicyReconciler := controllers.NewTarantoolBackupPolicyReconciler(mgr)
+	if err = tarantoolBackupPolicyReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TarantoolBackupPolicy")
+		os.Exit(1)
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
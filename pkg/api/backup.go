@@ -0,0 +1,58 @@
+package api
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type TarantoolBackup interface {
+	client.Object
+
+	GetRoles() []string
+	IsAllInstances() bool
+	IsVolumeSnapshot() bool
+
+	IsCompleted() bool
+	IsFinished() bool
+	IsSnapshotted() bool
+
+	GetInstancePods() []string
+	SetInstanceUploaded(pod string)
+	IsInstanceUploaded(pod string) bool
+
+	SetStartedAt(startedAt time.Time)
+	SetCompletedAt(completedAt time.Time)
+	GetCompletedAt() time.Time
+	SetMessage(message string)
+}
+
+type TarantoolBackupWithStatus[PhaseType comparable] interface {
+	TarantoolBackup
+
+	SetPhase(phase PhaseType)
+	GetPhase() PhaseType
+}
+
+type TarantoolRestore interface {
+	client.Object
+
+	GetBackupName() string
+
+	IsFinished() bool
+	IsStarted() bool
+
+	GetInstancePods() []string
+	SetInstanceRestored(pod string)
+	IsInstanceRestored(pod string) bool
+
+	SetCompletedAt(completedAt time.Time)
+	SetMessage(message string)
+}
+
+type TarantoolRestoreWithStatus[PhaseType comparable] interface {
+	TarantoolRestore
+
+	SetPhase(phase PhaseType)
+	GetPhase() PhaseType
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type TarantoolBackupPolicy interface {
+	client.Object
+
+	GetSchedule() string
+	IsSuspended() bool
+
+	// GetKeepLast returns 0 when number of kept backups is not limited
+	GetKeepLast() int32
+	// GetKeepFor returns 0 when backups are kept forever
+	GetKeepFor() time.Duration
+
+	GetLastScheduleTime() time.Time
+	SetLastScheduleTime(scheduleTime time.Time)
+	SetNextScheduleTime(scheduleTime time.Time)
+	GetNextScheduleTime() time.Time
+
+	SetLastSuccessful(name string, completedAt time.Time)
+	GetLastSuccessfulTime() time.Time
+	SetLastFailed(name string, failedAt time.Time)
+	GetLastFailureTime() time.Time
+	SetActive(active []string)
+
+	SetMessage(message string)
+}
+
+type TarantoolBackupPolicyWithStatus[PhaseType comparable] interface {
+	TarantoolBackupPolicy
+
+	SetPhase(phase PhaseType)
+	GetPhase() PhaseType
+}
@@ -1,17 +1,51 @@
 package api
 
 import (
+	"time"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+type CartridgeConfigSourceKind string
+
+const (
+	CartridgeConfigSourceConfigMap CartridgeConfigSourceKind = "ConfigMap"
+	CartridgeConfigSourceSecret    CartridgeConfigSourceKind = "Secret"
+)
+
 type CartridgeConfig interface {
 	client.Object
 
 	GetData() []byte
+	GetSources() []CartridgeConfigSource
+	GetPriority() int32
+
+	SetOwnedSections(sections []string)
+	GetOwnedSections() []string
+
+	IsObserveOnly() bool
+	GetDriftCheckInterval() time.Duration
+	SetDrifted(changedKeys []string)
+	AddHistoryEntry(hash string, appliedAt time.Time)
+	GetLastAppliedHash() string
+
+	SetError(className, message string)
 
 	ResetStatus()
 }
 
+type CartridgeConfigSource interface {
+	GetKind() CartridgeConfigSourceKind
+	GetName() string
+	GetItems() []CartridgeConfigSourceItem
+	IsOptional() bool
+}
+
+type CartridgeConfigSourceItem interface {
+	GetKey() string
+	GetSection() string
+}
+
 type CartridgeConfigWithStatus[PhaseType comparable] interface {
 	CartridgeConfig
 
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type CartridgeSchema interface {
+	client.Object
+
+	GetSchema() string
+	IsDestructiveAllowed() bool
+
+	SetAppliedVersion(version string)
+	GetAppliedVersion() string
+	SetError(className, message string)
+
+	ResetStatus()
+}
+
+type CartridgeSchemaWithStatus[PhaseType comparable] interface {
+	CartridgeSchema
+
+	SetPhase(phase PhaseType)
+	GetPhase() PhaseType
+}
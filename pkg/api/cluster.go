@@ -1,16 +1,35 @@
 package api
 
 import (
+	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+type ClusterFlavor string
+
+const (
+	// ClusterFlavorCartridge clusters are configured through Cartridge topology API
+	ClusterFlavorCartridge ClusterFlavor = "cartridge"
+	// ClusterFlavorTarantool3 clusters are configured by declarative cluster config of Tarantool 3.x
+	ClusterFlavorTarantool3 ClusterFlavor = "tarantool3"
+	// ClusterFlavorPlain clusters are replicasets of Tarantool without Cartridge, replication is set by box.cfg
+	ClusterFlavorPlain ClusterFlavor = "plain"
+)
+
 type Cluster interface {
 	client.Object
 
+	GetFlavor() ClusterFlavor
+	GetTarantool3Config() Tarantool3Config
+
 	GetDomain() string
 	GetListenPort() int32
 
 	GetFailoverConfig() FailoverConfig
+	// GetMetricsConfig returns nil if metrics of instances are not exposed
+	GetMetricsConfig() MetricsConfig
+	// GetTLSConfig returns nil if iproto connections are not encrypted
+	GetTLSConfig() TLSConfig
 
 	SetLeader(leader string)
 	GetLeader() string
@@ -18,9 +37,44 @@ type Cluster interface {
 	MarkBootstrapped()
 	IsBootstrapped() bool
 
+	// GetAppliedConfigHash returns hash of Tarantool 3.x cluster config reloaded by all instances
+	GetAppliedConfigHash() string
+	SetAppliedConfigHash(hash string)
+
 	ResetStatus()
 }
 
+type MetricsConfig interface {
+	GetPort() int32
+	GetPath() string
+	GetInterval() string
+	GetLabels() map[string]string
+}
+
+type TLSConfig interface {
+	GetSecretName() string
+	GetMountPath() string
+	GetCiphers() string
+	// GetIssuerName returns empty string if certificates are not issued by cert-manager
+	GetIssuerName() string
+	GetIssuerKind() string
+	GetIssuerGroup() string
+}
+
+type Tarantool3Config interface {
+	GetUsers() []ClusterUser
+	GetPeerUser() string
+	// GetConfig returns YAML of user-defined options of cluster config
+	GetConfig() string
+}
+
+type ClusterUser interface {
+	GetName() string
+	GetRoles() []string
+	// GetPasswordSecretKeyRef returns nil if user has no password
+	GetPasswordSecretKeyRef() *v1.SecretKeySelector
+}
+
 type ClusterWithStatus[PhaseType comparable] interface {
 	Cluster
 
@@ -1,5 +1,11 @@
 package api
 
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
 type FailoverMode string
 
 const (
@@ -26,8 +32,26 @@ type FailoverConfig interface {
 
 	GetETCD2Config() FailoverETCD2Config
 	GetStateboardConfig() FailoverStateboardConfig
+	// GetRaftConfig returns nil if Raft is not tuned
+	GetRaftConfig() FailoverRaftConfig
+}
+
+type FailoverRaftConfig interface {
+	// GetElectionTimeout returns 0 if default of Tarantool is used
+	GetElectionTimeout() time.Duration
+	// GetSynchroQuorum returns nil if default of Tarantool is used
+	GetSynchroQuorum() *intstr.IntOrString
 }
 
+// ElectionMode is a box.cfg election_mode of instances of role.
+type ElectionMode string
+
+const (
+	ElectionModeCandidate ElectionMode = "candidate"
+	ElectionModeVoter     ElectionMode = "voter"
+	ElectionModeOff       ElectionMode = "off"
+)
+
 type FailoverETCD2Config interface {
 	GetEndpoints() []string
 	GetUsername() string
@@ -1,27 +1,47 @@
 package api
 
 import (
+	"time"
+
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
-	RolePending               string = "Pending"
-	RoleWaitingForCluster     string = "WaitingForCluster"
-	RoleWaitingForLeader      string = "WaitingForLeader"
-	RoleWaitForCartridgeReady string = "WaitForCartridgeReady"
-	RoleJoining               string = "Joining"
-	RoleConfiguring           string = "Configuring"
-	RoleWaitingForBootstrap   string = "WaitingForBootstrap"
-	RoleConfiguringWeights    string = "ConfiguringWeights"
-	RoleReady                 string = "Ready"
-	RoleConfigError           string = "ConfigError"
+	RolePending                string = "Pending"
+	RoleWaitingForCluster      string = "WaitingForCluster"
+	RoleWaitingForRestore      string = "WaitingForRestore"
+	RoleExpandingVolumes       string = "ExpandingVolumes"
+	RoleWaitingForLeader       string = "WaitingForLeader"
+	RoleWaitForCartridgeReady  string = "WaitForCartridgeReady"
+	RoleJoining                string = "Joining"
+	RoleConfiguring            string = "Configuring"
+	RoleWaitingForBootstrap    string = "WaitingForBootstrap"
+	RoleConfiguringWeights     string = "ConfiguringWeights"
+	RoleConfiguringFailover    string = "ConfiguringFailoverPriority"
+	RoleConfiguringReplication string = "ConfiguringReplication"
+	RoleConfiguringElection    string = "ConfiguringElection"
+	RoleScalingDown            string = "ScalingDown"
+	RoleReady                  string = "Ready"
+	RoleConfigError            string = "ConfigError"
+)
+
+const (
+	// ScaleOutMaxNotSet is a reason of blocked scale out when storage policy has no valid maxReplicasets
+	ScaleOutMaxNotSet string = "MaxReplicasetsNotSet"
+	// ScaleOutMaxReached is a reason of blocked scale out when role already has maxReplicasets
+	ScaleOutMaxReached string = "MaxReplicasetsReached"
 )
 
 type Role interface {
 	client.Object
 
 	IsAllRw() bool
+	// IsStorage returns true if replicasets of role have vshard-storage cluster role
+	IsStorage() bool
+	// IsAutoscalingAllowed returns true if role is stateless or autoscaling of storage is explicitly allowed
+	IsAutoscalingAllowed() bool
 
 	GetReplicasetName(ordinal int32) (string, error)
 
@@ -31,6 +51,37 @@ type Role interface {
 	GetVolumeClaimTemplates() []v1.PersistentVolumeClaim
 	GetVShardConfig() VShardConfig
 
+	// GetPrimaryZone returns zone where masters of replicasets are preferred to be, empty if there is no preference
+	GetPrimaryZone() string
+	// GetInstanceZone returns zone of pod observed in current reconciliation
+	GetInstanceZone(pod string) string
+
+	// GetReplicationConfig returns nil if replication options of instances are not tuned
+	GetReplicationConfig() ReplicationConfig
+	// GetElectionMode returns box.cfg election_mode of instances used by "raft" failover mode
+	GetElectionMode() ElectionMode
+	// SetReplicasetElection records Raft term and leader of replicaset observed in current reconciliation
+	SetReplicasetElection(replicaset string, term int64, leader string)
+
+	// GetStoragePolicy returns nil if storage usage is not checked
+	GetStoragePolicy() StoragePolicy
+	// SetReplicasets changes desired number of replicasets in spec of role
+	SetReplicasets(replicasets int32)
+	GetLastScaleOutTime() time.Time
+	// SetLastScaleOut records time and number of replicasets set by storage policy
+	SetLastScaleOut(t time.Time, replicasets int32)
+	// SetStorageUsageHigh records instances which storage usage exceeds thresholds, empty if there are no such instances
+	SetStorageUsageHigh(instances []string)
+	ClearStorageUsage()
+	// SetScaleDownBlocked records replicasets kept beyond scale, it returns true if the set of replicasets is changed
+	SetScaleDownBlocked(replicasets []string) bool
+	// SetScaleOutBlocked records why storage policy can not add a replicaset, it returns true if condition is changed
+	SetScaleOutBlocked(reason, message string) bool
+	// SetVolumesNotExpandable records volume claims which can not be expanded, it returns true if the set of claims is changed
+	SetVolumesNotExpandable(claims []string) bool
+	// SetSingleZoneReplicasets records replicasets placed in one zone, it returns true if condition is changed
+	SetSingleZoneReplicasets(replicasets []string) bool
+
 	ResetStatus()
 
 	SetReadyPodsCount(count int32)
@@ -42,6 +93,28 @@ type VShardConfig interface {
 	GetWeight() int32
 }
 
+type ReplicationConfig interface {
+	// GetSynchroQuorum returns nil if default of Tarantool is used
+	GetSynchroQuorum() *intstr.IntOrString
+	// GetSynchroTimeout returns 0 if default of Tarantool is used
+	GetSynchroTimeout() time.Duration
+	// GetTimeout returns 0 if default of Tarantool is used
+	GetTimeout() time.Duration
+	// GetConnectQuorum returns nil if default of Tarantool is used
+	GetConnectQuorum() *int32
+}
+
+type StoragePolicy interface {
+	GetMemtxThreshold() int32
+	GetVinylThreshold() int32
+	// GetVinylDiskLimit returns 0 if vinyl usage is not checked
+	GetVinylDiskLimit() int64
+	IsScaleOut() bool
+	GetMaxReplicasets() int32
+	GetInterval() time.Duration
+	GetCooldown() time.Duration
+}
+
 type RoleWithStatus[PhaseType comparable] interface {
 	Role
 
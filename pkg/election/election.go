@@ -19,7 +19,7 @@ type LeaderElection struct {
 	k8s.ResourcesManager
 	*events.Recorder
 
-	Topology topology.CartridgeTopology
+	Membership topology.Membership
 }
 
 var (
@@ -196,7 +196,7 @@ func (r *LeaderElection) CanBeLeader(ctx context.Context, cluster api.Cluster, p
 	}
 
 	if !cluster.IsBootstrapped() {
-		started, err := r.Topology.IsCartridgeStarted(ctx, pod)
+		started, err := r.Membership.IsStarted(ctx, pod)
 		if err != nil {
 			return false, err
 		}
@@ -204,7 +204,7 @@ func (r *LeaderElection) CanBeLeader(ctx context.Context, cluster api.Cluster, p
 		return started, nil
 	}
 
-	configured, err := r.Topology.IsCartridgeConfigured(ctx, pod)
+	configured, err := r.Membership.IsConfigured(ctx, pod)
 	if err != nil {
 		return false, err
 	}
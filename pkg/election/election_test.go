@@ -20,7 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func newTestElection(fakeClient client.Client, fakeTopologyService *mocks.FakeCartridgeTopology) *LeaderElection {
+func newTestElection(fakeClient client.Client, fakeMembership *mocks.FakeMembership) *LeaderElection {
 	resourcesManager := &ResourcesManager{
 		LabelsManager: labelsManager,
 		CommonResourcesManager: &k8s.CommonResourcesManager{
@@ -33,7 +33,7 @@ func newTestElection(fakeClient client.Client, fakeTopologyService *mocks.FakeCa
 		Client:           fakeClient,
 		Recorder:         events.NewRecorder(record.NewFakeRecorder(10)),
 		ResourcesManager: resourcesManager,
-		Topology:         fakeTopologyService,
+		Membership:       fakeMembership,
 	}
 }
 
@@ -61,9 +61,9 @@ var _ = Describe("election unit testing", func() {
 					},
 				},
 			).Build()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
+			fakeMembership := mocks.NewFakeMembership()
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).To(HaveOccurred())
@@ -80,9 +80,9 @@ var _ = Describe("election unit testing", func() {
 			cartridge.WithStoragePodsCreated()
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
+			fakeMembership := mocks.NewFakeMembership()
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).To(HaveOccurred())
@@ -100,9 +100,9 @@ var _ = Describe("election unit testing", func() {
 			cartridge.WithAllPodsDeleting()
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
+			fakeMembership := mocks.NewFakeMembership()
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).To(HaveOccurred())
@@ -120,12 +120,12 @@ var _ = Describe("election unit testing", func() {
 			cartridge.WithAllPodsRunning()
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
-			fakeTopologyService.
-				On("IsCartridgeStarted", mock.Anything, mock.Anything).
+			fakeMembership := mocks.NewFakeMembership()
+			fakeMembership.
+				On("IsStarted", mock.Anything, mock.Anything).
 				Return(false, nil)
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).To(HaveOccurred())
@@ -151,12 +151,12 @@ var _ = Describe("election unit testing", func() {
 				},
 			).Build()
 
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
-			fakeTopologyService.
-				On("IsCartridgeStarted", mock.Anything, mock.Anything).
+			fakeMembership := mocks.NewFakeMembership()
+			fakeMembership.
+				On("IsStarted", mock.Anything, mock.Anything).
 				Return(true, nil)
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).NotTo(HaveOccurred())
@@ -178,12 +178,12 @@ var _ = Describe("election unit testing", func() {
 			cartridge.Bootstrapped()
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
-			fakeTopologyService.
-				On("IsCartridgeConfigured", mock.Anything, mock.Anything).
+			fakeMembership := mocks.NewFakeMembership()
+			fakeMembership.
+				On("IsConfigured", mock.Anything, mock.Anything).
 				Return(true, nil)
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).NotTo(HaveOccurred())
@@ -204,20 +204,20 @@ var _ = Describe("election unit testing", func() {
 				cartridge.Bootstrapped()
 
 				fakeClient := cartridge.BuildFakeClient()
-				fakeTopologyService := new(mocks.FakeCartridgeTopology)
-				fakeTopologyService.
-					On("IsCartridgeConfigured", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
+				fakeMembership := mocks.NewFakeMembership()
+				fakeMembership.
+					On("IsConfigured", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
 						return pod.GetName() == "storage-0-0"
 					})).
 					Return(false, nil)
 
-				fakeTopologyService.
-					On("IsCartridgeConfigured", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
+				fakeMembership.
+					On("IsConfigured", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
 						return pod.GetName() == "storage-1-0"
 					})).
 					Return(true, nil)
 
-				election := newTestElection(fakeClient, fakeTopologyService)
+				election := newTestElection(fakeClient, fakeMembership)
 
 				leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 				Expect(err).NotTo(HaveOccurred())
@@ -239,12 +239,12 @@ var _ = Describe("election unit testing", func() {
 			cartridge.WithLeader("router-0-0")
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
-			fakeTopologyService.
-				On("IsCartridgeStarted", mock.Anything, mock.Anything).
+			fakeMembership := mocks.NewFakeMembership()
+			fakeMembership.
+				On("IsStarted", mock.Anything, mock.Anything).
 				Return(true, nil)
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).NotTo(HaveOccurred())
@@ -263,20 +263,20 @@ var _ = Describe("election unit testing", func() {
 			cartridge.WithLeader("router-0-0")
 
 			fakeClient := cartridge.BuildFakeClient()
-			fakeTopologyService := new(mocks.FakeCartridgeTopology)
-			fakeTopologyService.
-				On("IsCartridgeStarted", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
+			fakeMembership := mocks.NewFakeMembership()
+			fakeMembership.
+				On("IsStarted", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
 					return pod.GetName() == "router-0-0"
 				})).
 				Return(false, nil)
 
-			fakeTopologyService.
-				On("IsCartridgeStarted", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
+			fakeMembership.
+				On("IsStarted", mock.Anything, mock.MatchedBy(func(pod *v1.Pod) bool {
 					return pod.GetName() != "router-0-0"
 				})).
 				Return(true, nil)
 
-			election := newTestElection(fakeClient, fakeTopologyService)
+			election := newTestElection(fakeClient, fakeMembership)
 
 			leader, err := election.GetLeaderInstance(ctx, cartridge.Cluster)
 			Expect(err).To(HaveOccurred())
@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type BackupManager[BackupType api.TarantoolBackup] interface {
+	// RecordTopology must store replicasets and clusterwide config of the cluster alongside backup files
+	RecordTopology(ctx context.Context, cluster api.Cluster, backup BackupType, replicasets []topology.ReplicasetInfo, config topology.ClusterwideConfigData) error
+
+	// RecordInstance must remember snapshot files of instance in backup status
+	RecordInstance(backup BackupType, replicaset *topology.ReplicasetInfo, pod *v1.Pod, files *topology.BackupFiles) error
+
+	// EnsureUploadJob must return job which copies files of instance to backup storage, creating it when necessary
+	EnsureUploadJob(ctx context.Context, backup BackupType, pod string) (*batchv1.Job, error)
+
+	// EnsureVolumeSnapshot must return VolumeSnapshot of data volume of instance, creating it when necessary
+	EnsureVolumeSnapshot(ctx context.Context, backup BackupType, pod string) (*unstructured.Unstructured, error)
+}
+
+type RestoreManager[RestoreType api.TarantoolRestore, BackupType api.TarantoolBackup] interface {
+	GetBackup(ctx context.Context, restore RestoreType) (BackupType, error)
+
+	// PrepareVolumes must create volumes of instances recorded in backup and remember them in restore status
+	PrepareVolumes(ctx context.Context, cluster api.Cluster, restore RestoreType, backup BackupType) error
+
+	// EnsureDownloadJob must return job which copies files of instance from backup storage, creating it when necessary
+	EnsureDownloadJob(ctx context.Context, restore RestoreType, backup BackupType, pod string) (*batchv1.Job, error)
+}
@@ -0,0 +1,19 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+)
+
+type BackupPolicyManager[PolicyType api.TarantoolBackupPolicy, BackupType api.TarantoolBackup] interface {
+	// ListBackups must return all backups created by policy
+	ListBackups(ctx context.Context, policy PolicyType) ([]BackupType, error)
+
+	// CreateBackup must create backup from template of policy, the same scheduledAt must result in the same backup
+	CreateBackup(ctx context.Context, cluster api.Cluster, policy PolicyType, scheduledAt time.Time) (BackupType, error)
+
+	// PruneBackup must remove files of backup from storage and then delete backup, it returns true when backup is deleted
+	PruneBackup(ctx context.Context, backup BackupType) (bool, error)
+}
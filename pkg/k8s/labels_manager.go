@@ -15,6 +15,14 @@ type LabelsManager interface {
 	ReplicasetUUID() string
 	ReplicasetOrdinal() string
 	ReplicasetPodTemplateHash() string
+	ReplicasetCertificatesHash() string
+	BackupName() string
+	BackupPolicyName() string
+	RestoreName() string
+	Metrics() string
+	ReplicasetMaster() string
+	TopologyLeader() string
+	ManagedAnnotations() string
 
 	SelectorByClusterName(cluster api.Cluster) labels.Selector
 	SelectorByRoleName(role api.Role) labels.Selector
@@ -65,6 +73,44 @@ func (r *NamespacedLabelsManager) ReplicasetPodTemplateHash() string {
 	return r.namespacedLabel("replicaset-pod-template-hash")
 }
 
+// ReplicasetCertificatesHash is a hash of TLS Secret mounted into pods of StatefulSet, it tells renewal from other changes.
+func (r *NamespacedLabelsManager) ReplicasetCertificatesHash() string {
+	return r.namespacedLabel("replicaset-certificates-hash")
+}
+
+func (r *NamespacedLabelsManager) BackupName() string {
+	return r.namespacedLabel("backup-name")
+}
+
+func (r *NamespacedLabelsManager) BackupPolicyName() string {
+	return r.namespacedLabel("backup-policy-name")
+}
+
+func (r *NamespacedLabelsManager) RestoreName() string {
+	return r.namespacedLabel("restore-name")
+}
+
+// Metrics marks Services which expose metrics of instances.
+func (r *NamespacedLabelsManager) Metrics() string {
+	return r.namespacedLabel("metrics")
+}
+
+// ReplicasetMaster is "true" on pods of active masters of replicasets and "false" on replicas.
+func (r *NamespacedLabelsManager) ReplicasetMaster() string {
+	return r.namespacedLabel("replicaset-master")
+}
+
+// TopologyLeader is "true" on pod which is used by operator to control topology of cluster.
+func (r *NamespacedLabelsManager) TopologyLeader() string {
+	return r.namespacedLabel("topology-leader")
+}
+
+// ManagedAnnotations is an annotation with comma-separated keys of annotations set by operator,
+// so annotations removed from spec are deleted while annotations set by others are kept.
+func (r *NamespacedLabelsManager) ManagedAnnotations() string {
+	return r.namespacedLabel("managed-annotations")
+}
+
 func (r *NamespacedLabelsManager) SelectorByClusterName(cluster api.Cluster) labels.Selector {
 	return labels.SelectorFromSet(map[string]string{
 		r.ClusterName(): cluster.GetName(),
@@ -3,7 +3,9 @@ package k8s
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/tarantool/tarantool-operator/pkg/api"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -14,6 +16,52 @@ type ReplicasetsManger[RoleType api.Role] interface {
 	// GetAdvertiseURI must return stable URI as string for each replicaset
 	GetAdvertiseURI(cluster api.Cluster, pod *v1.Pod) string
 
+	// GetReplicationURIs must return advertise URIs of all desired replicas of StatefulSet in order of ordinals
+	GetReplicationURIs(cluster api.Cluster, sts *appsv1.StatefulSet) []string
+
+	// ValidateTLSSecret must return ErrInvalidTLSSecret if Secret with certificates of cluster is absent
+	// or lacks any of tls.crt, tls.key and ca.crt keys
+	ValidateTLSSecret(ctx context.Context, cluster api.Cluster) error
+
 	CreateStatefulSets(ctx context.Context, cluster api.Cluster, role RoleType) error
 	UpdateStatefulSets(ctx context.Context, cluster api.Cluster, role RoleType) (complete bool, err error)
+
+	// SyncPodDisruptionBudgets must create or update PodDisruptionBudget for each StatefulSet of role
+	SyncPodDisruptionBudgets(ctx context.Context, cluster api.Cluster, role RoleType) error
+
+	// SyncServices must create or update client Services of role and delete them when they are disabled
+	SyncServices(ctx context.Context, cluster api.Cluster, role RoleType) error
+
+	// SetMasterLabels must mark pods of role which are active masters of replicasets
+	// with replicaset master label and other pods of role as replicas
+	SetMasterLabels(ctx context.Context, role RoleType, masters map[string]bool) error
+
+	// ReportZones must record node and zone of each pod of role in role status
+	// and return zones of replicasets which have all replicas in the same zone by names of replicasets
+	ReportZones(ctx context.Context, role RoleType) (map[string]string, error)
+
+	// ExpandVolumeClaims must resize PersistentVolumeClaims of role up to the size of volume claim templates
+	// and delete StatefulSets with outdated volume claim templates leaving their pods orphaned,
+	// so they are created again by CreateStatefulSets
+	ExpandVolumeClaims(ctx context.Context, role RoleType) (*VolumeExpansion, error)
+
+	// ReportScale must record number of StatefulSets of role and selector of its pods in role status
+	ReportScale(ctx context.Context, role RoleType) error
+
+	// GetRemovedReplicasets must return StatefulSets of role which ordinals are not less than number of replicasets
+	GetRemovedReplicasets(ctx context.Context, role RoleType) ([]appsv1.StatefulSet, error)
+
+	// RemoveReplicaset must delete StatefulSet of expelled replicaset together with its PodDisruptionBudget
+	// and PersistentVolumeClaims
+	RemoveReplicaset(ctx context.Context, role RoleType, sts *appsv1.StatefulSet) error
+}
+
+var ErrInvalidTLSSecret = errors.New("invalid TLS secret")
+
+type VolumeExpansion struct {
+	// Recreated contains names of deleted StatefulSets
+	Recreated []string
+
+	// NotExpandable contains names of PersistentVolumeClaims which StorageClass does not allow expansion
+	NotExpandable []string
 }
@@ -28,7 +28,14 @@ type ResourcesManager interface {
 	GetSecretValue(ctx context.Context, ns, name, key string) (string, error)
 
 	GetCluster(ctx context.Context, ns, name string) (api.Cluster, error)
+	ListClusters(ctx context.Context) ([]api.Cluster, error)
 	GetClusterRoles(ctx context.Context, cluster api.Cluster) ([]api.Role, error)
+	GetClusterCartridgeConfigs(ctx context.Context, cluster api.Cluster) ([]api.CartridgeConfig, error)
+
+	// IsClusterRestoring must return true while any TarantoolRestore of cluster is not finished
+	IsClusterRestoring(ctx context.Context, cluster api.Cluster) (bool, error)
+	// SyncClusterConfig renders Tarantool 3.x config of cluster into ConfigMap and returns its md5 checksum
+	SyncClusterConfig(ctx context.Context, cluster api.Cluster) (string, error)
 }
 
 type CommonResourcesManager struct {
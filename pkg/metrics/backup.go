@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	backupPolicyLastSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tarantool_operator_backup_policy_last_success_timestamp_seconds",
+			Help: "Completion time of the most recent completed backup created by policy.",
+		},
+		[]string{"namespace", "policy"},
+	)
+	backupPolicyLastFailure = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tarantool_operator_backup_policy_last_failure_timestamp_seconds",
+			Help: "Creation time of the most recent failed backup created by policy.",
+		},
+		[]string{"namespace", "policy"},
+	)
+	backupPolicyActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tarantool_operator_backup_policy_active_backups",
+			Help: "Number of not finished backups created by policy.",
+		},
+		[]string{"namespace", "policy"},
+	)
+	backupPolicyPruned = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tarantool_operator_backup_policy_pruned_backups_total",
+			Help: "Number of backups pruned according to retention of policy.",
+		},
+		[]string{"namespace", "policy"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		backupPolicyLastSuccess,
+		backupPolicyLastFailure,
+		backupPolicyActive,
+		backupPolicyPruned,
+	)
+}
+
+// ObserveBackupPolicy exports status of policy.
+func ObserveBackupPolicy(policy api.TarantoolBackupPolicy, active int) {
+	namespace, name := policy.GetNamespace(), policy.GetName()
+
+	backupPolicyLastSuccess.WithLabelValues(namespace, name).Set(timestamp(policy.GetLastSuccessfulTime()))
+	backupPolicyLastFailure.WithLabelValues(namespace, name).Set(timestamp(policy.GetLastFailureTime()))
+	backupPolicyActive.WithLabelValues(namespace, name).Set(float64(active))
+}
+
+// BackupPruned counts backup pruned by policy.
+func BackupPruned(policy api.TarantoolBackupPolicy) {
+	backupPolicyPruned.WithLabelValues(policy.GetNamespace(), policy.GetName()).Inc()
+}
+
+// ForgetBackupPolicy removes series of deleted policy.
+func ForgetBackupPolicy(namespace, name string) {
+	backupPolicyLastSuccess.DeleteLabelValues(namespace, name)
+	backupPolicyLastFailure.DeleteLabelValues(namespace, name)
+	backupPolicyActive.DeleteLabelValues(namespace, name)
+	backupPolicyPruned.DeleteLabelValues(namespace, name)
+}
+
+func timestamp(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return float64(t.Unix())
+}
@@ -0,0 +1,12 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+)
+
+type BackupContext[BackupType api.TarantoolBackup] interface {
+	Context
+
+	SetTarantoolBackup(backup BackupType)
+	GetTarantoolBackup() BackupType
+}
@@ -0,0 +1,16 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+)
+
+type BackupController[BackupType api.TarantoolBackup] interface {
+	Controller
+
+	GetBackupManager() k8s.BackupManager[BackupType]
+}
+
+type CommonBackupController struct {
+	*CommonController
+}
@@ -0,0 +1,19 @@
+package reconciliation
+
+import (
+	"github.com/robfig/cron/v3"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+)
+
+type BackupPolicyContext[PolicyType api.TarantoolBackupPolicy, BackupType api.TarantoolBackup] interface {
+	Context
+
+	SetTarantoolBackupPolicy(policy PolicyType)
+	GetTarantoolBackupPolicy() PolicyType
+
+	SetSchedule(schedule cron.Schedule)
+	GetSchedule() cron.Schedule
+
+	SetBackups(backups []BackupType)
+	GetBackups() []BackupType
+}
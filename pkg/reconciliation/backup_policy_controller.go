@@ -0,0 +1,16 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+)
+
+type BackupPolicyController[PolicyType api.TarantoolBackupPolicy, BackupType api.TarantoolBackup] interface {
+	Controller
+
+	GetBackupPolicyManager() k8s.BackupPolicyManager[PolicyType, BackupType]
+}
+
+type CommonBackupPolicyController struct {
+	*CommonController
+}
@@ -2,11 +2,15 @@ package reconciliation
 
 import (
 	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
 )
 
 type CartridgeConfigContext[ConfigType api.CartridgeConfig] interface {
 	Context
 
 	SetCartridgeConfig(config ConfigType)
-	GetCartridgeConfig() ConfigType
+	GetConfig() ConfigType
+
+	SetDesiredConfig(config topology.ClusterwideConfigData)
+	GetDesiredConfig() topology.ClusterwideConfigData
 }
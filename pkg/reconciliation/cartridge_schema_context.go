@@ -0,0 +1,12 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+)
+
+type CartridgeSchemaContext[SchemaType api.CartridgeSchema] interface {
+	Context
+
+	SetCartridgeSchema(schema SchemaType)
+	GetSchema() SchemaType
+}
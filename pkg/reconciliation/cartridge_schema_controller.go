@@ -0,0 +1,9 @@
+package reconciliation
+
+type CartridgeSchemaController interface {
+	Controller
+}
+
+type CommonCartridgeSchemaController struct {
+	*CommonController
+}
@@ -17,7 +17,18 @@ type CommonController struct {
 	ResourcesManager k8s.ResourcesManager
 	LabelsManager    k8s.LabelsManager
 	EventsRecorder   *events.Recorder
-	Topology         topology.CartridgeTopology
+	// Capabilities of topology backend, controllers set capabilities used by their steps
+	Membership         topology.Membership
+	ReplicasetConfig   topology.ReplicasetConfig
+	Sharding           topology.Sharding
+	Failover           topology.Failover
+	ClusterwideConfig  topology.ClusterwideConfig
+	Backup             topology.Backup
+	Storage            topology.Storage
+	Election           topology.Election
+	ReplicationOptions topology.ReplicationOptions
+	Replication        topology.Replication
+	DeclarativeConfig  topology.DeclarativeConfig
 }
 
 func (r *CommonController) GetLeaderElection() *election.LeaderElection {
@@ -40,6 +51,46 @@ func (r *CommonController) GetScheme() *runtime.Scheme {
 	return r.Schema
 }
 
-func (r *CommonController) GetTopology() topology.CartridgeTopology {
-	return r.Topology
+func (r *CommonController) GetMembership() topology.Membership {
+	return r.Membership
+}
+
+func (r *CommonController) GetReplicasetConfig() topology.ReplicasetConfig {
+	return r.ReplicasetConfig
+}
+
+func (r *CommonController) GetSharding() topology.Sharding {
+	return r.Sharding
+}
+
+func (r *CommonController) GetFailover() topology.Failover {
+	return r.Failover
+}
+
+func (r *CommonController) GetClusterwideConfig() topology.ClusterwideConfig {
+	return r.ClusterwideConfig
+}
+
+func (r *CommonController) GetBackup() topology.Backup {
+	return r.Backup
+}
+
+func (r *CommonController) GetStorage() topology.Storage {
+	return r.Storage
+}
+
+func (r *CommonController) GetElection() topology.Election {
+	return r.Election
+}
+
+func (r *CommonController) GetReplicationOptions() topology.ReplicationOptions {
+	return r.ReplicationOptions
+}
+
+func (r *CommonController) GetReplication() topology.Replication {
+	return r.Replication
+}
+
+func (r *CommonController) GetDeclarativeConfig() topology.DeclarativeConfig {
+	return r.DeclarativeConfig
 }
@@ -17,5 +17,15 @@ type Controller interface {
 	GetResourcesManager() k8s.ResourcesManager
 	GetLabelsManager() k8s.LabelsManager
 	GetEventsRecorder() *events.Recorder
-	GetTopology() topology.CartridgeTopology
+	GetMembership() topology.Membership
+	GetReplicasetConfig() topology.ReplicasetConfig
+	GetSharding() topology.Sharding
+	GetFailover() topology.Failover
+	GetClusterwideConfig() topology.ClusterwideConfig
+	GetBackup() topology.Backup
+	GetStorage() topology.Storage
+	GetElection() topology.Election
+	GetReplicationOptions() topology.ReplicationOptions
+	GetDeclarativeConfig() topology.DeclarativeConfig
+	GetReplication() topology.Replication
 }
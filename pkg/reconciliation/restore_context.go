@@ -0,0 +1,15 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+)
+
+type RestoreContext[RestoreType api.TarantoolRestore, BackupType api.TarantoolBackup] interface {
+	Context
+
+	SetTarantoolRestore(restore RestoreType)
+	GetTarantoolRestore() RestoreType
+
+	SetTarantoolBackup(backup BackupType)
+	GetTarantoolBackup() BackupType
+}
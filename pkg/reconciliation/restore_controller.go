@@ -0,0 +1,16 @@
+package reconciliation
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+)
+
+type RestoreController[RestoreType api.TarantoolRestore, BackupType api.TarantoolBackup] interface {
+	Controller
+
+	GetRestoreManager() k8s.RestoreManager[RestoreType, BackupType]
+}
+
+type CommonRestoreController struct {
+	*CommonController
+}
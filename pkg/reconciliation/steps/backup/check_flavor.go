@@ -0,0 +1,35 @@
+package backup
+
+import (
+	"fmt"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// CheckFlavorStep fails backup of cluster which is not managed by Cartridge,
+// snapshots are pinned and topology is recorded through Cartridge API only.
+type CheckFlavorStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct {
+	// FailedPhase is set when flavor of cluster is not supported
+	FailedPhase PhaseType
+}
+
+func (r *CheckFlavorStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Check flavor of cluster"
+}
+
+func (r *CheckFlavorStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	flavor := ctx.GetRelatedCluster().GetFlavor()
+	if flavor == api.ClusterFlavorCartridge {
+		return NextStep()
+	}
+
+	message := fmt.Sprintf("backups are supported only for %s flavor, cluster has %s flavor", api.ClusterFlavorCartridge, flavor)
+
+	backup := ctx.GetTarantoolBackup()
+	backup.SetPhase(r.FailedPhase)
+	backup.SetMessage(message)
+	ctrl.GetEventsRecorder().Event(backup, NewBackupFailedEvent(message))
+
+	return Complete()
+}
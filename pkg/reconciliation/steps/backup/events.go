@@ -0,0 +1,38 @@
+package backup
+
+import (
+	"fmt"
+
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventSnapshotsCreated = "SnapshotsCreated"
+	EventBackupCompleted  = "BackupCompleted"
+	EventBackupFailed     = "BackupFailed"
+)
+
+func NewSnapshotsCreatedEvent(instances int) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventSnapshotsCreated,
+		Message:   fmt.Sprintf("Snapshots of %d instances created, uploading files.", instances),
+	}
+}
+
+func NewBackupCompletedEvent(instances int) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventBackupCompleted,
+		Message:   fmt.Sprintf("Backup of %d instances completed successfully.", instances),
+	}
+}
+
+func NewBackupFailedEvent(message string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventBackupFailed,
+		Message:   fmt.Sprintf("Backup failed: %s", message),
+	}
+}
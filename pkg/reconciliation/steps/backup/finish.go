@@ -0,0 +1,26 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// FinishStep releases pinned files on instances when all files are uploaded.
+type FinishStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct{}
+
+func (r *FinishStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Finish backup"
+}
+
+func (r *FinishStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	backup := ctx.GetTarantoolBackup()
+
+	releaseBackup[PhaseType, BackupType, CtxType, CtrlType](ctx, ctrl)
+
+	backup.SetCompletedAt(time.Now())
+	ctrl.GetEventsRecorder().Event(backup, NewBackupCompletedEvent(len(backup.GetInstancePods())))
+
+	return NextStep()
+}
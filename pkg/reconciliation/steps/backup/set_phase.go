@@ -0,0 +1,20 @@
+package backup
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SetPhaseStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct {
+	Phase PhaseType
+}
+
+func (r *SetPhaseStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Set backup phase"
+}
+
+func (r *SetPhaseStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	ctx.GetTarantoolBackup().SetPhase(r.Phase)
+
+	return NextStep()
+}
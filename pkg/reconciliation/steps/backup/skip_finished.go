@@ -0,0 +1,22 @@
+package backup
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// SkipFinishedStep stops reconciliation of backup which is already completed or failed,
+// backup is never taken again.
+type SkipFinishedStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct{}
+
+func (r *SkipFinishedStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Skip finished backup"
+}
+
+func (r *SkipFinishedStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	if ctx.GetTarantoolBackup().IsFinished() {
+		return Complete()
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,120 @@
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type SnapshotStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct {
+	// FailedPhase is set when there is nothing to back up
+	FailedPhase PhaseType
+}
+
+func (r *SnapshotStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Make snapshots"
+}
+
+func (r *SnapshotStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	backup := ctx.GetTarantoolBackup()
+	if backup.IsSnapshotted() {
+		return NextStep()
+	}
+
+	replicasets, err := ctrl.GetMembership().GetReplicasets(ctx, ctx.GetLeader())
+	if err != nil {
+		return Error(err)
+	}
+
+	config, err := ctrl.GetClusterwideConfig().GetConfig(ctx, ctx.GetLeader())
+	if err != nil {
+		return Error(err)
+	}
+
+	err = ctrl.GetBackupManager().RecordTopology(ctx, ctx.GetRelatedCluster(), backup, replicasets, config)
+	if err != nil {
+		return Error(err)
+	}
+
+	instances := 0
+
+	for k := range replicasets {
+		replicaset := &replicasets[k]
+
+		for _, server := range r.selectServers(backup, replicaset) {
+			pod, err := ctrl.GetResourcesManager().GetPod(ctx, backup.GetNamespace(), utils.PodNameFromURI(server.URI))
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					return Requeue(10 * time.Second)
+				}
+
+				return Error(err)
+			}
+
+			roles := backup.GetRoles()
+			if len(roles) > 0 && !utils.SliceContains(roles, pod.GetLabels()[ctrl.GetLabelsManager().RoleName()]) {
+				continue
+			}
+
+			files, err := ctrl.GetBackup().StartBackup(ctx, pod)
+			if err != nil {
+				return Error(errors.Wrapf(err, "unable to make snapshot of %s", pod.GetName()))
+			}
+
+			err = ctrl.GetBackupManager().RecordInstance(backup, replicaset, pod, files)
+			if err != nil {
+				return Error(err)
+			}
+
+			instances++
+		}
+	}
+
+	if instances == 0 {
+		backup.SetPhase(r.FailedPhase)
+		backup.SetMessage("there are no instances matching backup spec")
+		ctrl.GetEventsRecorder().Event(backup, NewBackupFailedEvent("there are no instances matching backup spec"))
+
+		return Complete()
+	}
+
+	backup.SetStartedAt(time.Now())
+	ctrl.GetEventsRecorder().Event(backup, NewSnapshotsCreatedEvent(instances))
+
+	return NextStep()
+}
+
+func (r *SnapshotStep[PhaseType, BackupType, CtxType, CtrlType]) selectServers(backup BackupType, replicaset *topology.ReplicasetInfo) []topology.ServerInfo {
+	if backup.IsAllInstances() {
+		return replicaset.Servers
+	}
+
+	for _, server := range replicaset.Servers {
+		if server.URI == replicaset.MasterURI {
+			return []topology.ServerInfo{server}
+		}
+	}
+
+	return nil
+}
+
+func releaseBackup[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]](ctx CtxType, ctrl CtrlType) {
+	backup := ctx.GetTarantoolBackup()
+
+	for _, podName := range backup.GetInstancePods() {
+		pod, err := ctrl.GetResourcesManager().GetPod(ctx, backup.GetNamespace(), podName)
+		if err == nil {
+			err = ctrl.GetBackup().StopBackup(ctx, pod)
+		}
+
+		if err != nil {
+			ctx.GetLogger().Error(err, fmt.Sprintf("Unable to stop backup on %s, files stay pinned until restart", podName))
+		}
+	}
+}
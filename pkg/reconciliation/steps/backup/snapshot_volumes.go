@@ -0,0 +1,71 @@
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// SnapshotVolumesStep takes VolumeSnapshots of data volumes while snapshot files are pinned on instances.
+type SnapshotVolumesStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct {
+	// FailedPhase is set when any volume snapshot is failed
+	FailedPhase PhaseType
+}
+
+func (r *SnapshotVolumesStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Snapshot volumes"
+}
+
+func (r *SnapshotVolumesStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	backup := ctx.GetTarantoolBackup()
+	if !backup.IsVolumeSnapshot() {
+		return NextStep()
+	}
+
+	var (
+		pending bool
+		failed  []string
+	)
+
+	for _, pod := range backup.GetInstancePods() {
+		if backup.IsInstanceUploaded(pod) {
+			continue
+		}
+
+		snapshot, err := ctrl.GetBackupManager().EnsureVolumeSnapshot(ctx, backup, pod)
+		if err != nil {
+			return Error(err)
+		}
+
+		switch {
+		case utils.IsVolumeSnapshotReady(snapshot):
+			backup.SetInstanceUploaded(pod)
+		case utils.GetVolumeSnapshotError(snapshot) != "":
+			failed = append(failed, fmt.Sprintf("%s (%s)", snapshot.GetName(), utils.GetVolumeSnapshotError(snapshot)))
+		default:
+			pending = true
+		}
+	}
+
+	if len(failed) > 0 {
+		message := fmt.Sprintf("volume snapshots failed: %s", strings.Join(failed, ", "))
+
+		releaseBackup[PhaseType, BackupType, CtxType, CtrlType](ctx, ctrl)
+
+		backup.SetPhase(r.FailedPhase)
+		backup.SetMessage(message)
+		ctrl.GetEventsRecorder().Event(backup, NewBackupFailedEvent(message))
+
+		return Complete()
+	}
+
+	if pending {
+		return Requeue(10 * time.Second)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,67 @@
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+type UploadStep[PhaseType comparable, BackupType api.TarantoolBackupWithStatus[PhaseType], CtxType BackupContext[BackupType], CtrlType BackupController[BackupType]] struct {
+	// FailedPhase is set when any upload job is failed
+	FailedPhase PhaseType
+}
+
+func (r *UploadStep[PhaseType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Upload backup files"
+}
+
+func (r *UploadStep[PhaseType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	backup := ctx.GetTarantoolBackup()
+
+	var (
+		pending bool
+		failed  []string
+	)
+
+	for _, pod := range backup.GetInstancePods() {
+		if backup.IsInstanceUploaded(pod) {
+			continue
+		}
+
+		job, err := ctrl.GetBackupManager().EnsureUploadJob(ctx, backup, pod)
+		if err != nil {
+			return Error(err)
+		}
+
+		switch {
+		case utils.IsJobComplete(job):
+			backup.SetInstanceUploaded(pod)
+		case utils.IsJobFailed(job):
+			failed = append(failed, job.GetName())
+		default:
+			pending = true
+		}
+	}
+
+	if len(failed) > 0 {
+		message := fmt.Sprintf("upload jobs failed: %s", strings.Join(failed, ", "))
+
+		releaseBackup[PhaseType, BackupType, CtxType, CtrlType](ctx, ctrl)
+
+		backup.SetPhase(r.FailedPhase)
+		backup.SetMessage(message)
+		ctrl.GetEventsRecorder().Event(backup, NewBackupFailedEvent(message))
+
+		return Complete()
+	}
+
+	if pending {
+		return Requeue(10 * time.Second)
+	}
+
+	return NextStep()
+}
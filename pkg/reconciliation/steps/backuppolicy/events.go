@@ -0,0 +1,47 @@
+package backuppolicy
+
+import (
+	"fmt"
+
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventInvalidSchedule = "InvalidSchedule"
+	EventBackupScheduled = "BackupScheduled"
+	EventBackupSkipped   = "BackupSkipped"
+	EventBackupPruned    = "BackupPruned"
+)
+
+func NewInvalidScheduleEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventInvalidSchedule,
+		Message:   fmt.Sprintf("Unable to parse schedule: %s", err.Error()),
+	}
+}
+
+func NewBackupScheduledEvent(backup string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventBackupScheduled,
+		Message:   fmt.Sprintf("Backup %s created.", backup),
+	}
+}
+
+func NewBackupSkippedEvent(active string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventBackupSkipped,
+		Message:   fmt.Sprintf("Scheduled backup skipped, backup %s is still running.", active),
+	}
+}
+
+func NewBackupPrunedEvent(backup string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventBackupPruned,
+		Message:   fmt.Sprintf("Backup %s pruned according to retention.", backup),
+	}
+}
@@ -0,0 +1,31 @@
+package backuppolicy
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/metrics"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ForgetDeletedStep removes metrics of deleted policy, otherwise its series are exported until operator restarts.
+type ForgetDeletedStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct {
+	Target client.Object
+}
+
+func (r *ForgetDeletedStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Forget deleted policy"
+}
+
+func (r *ForgetDeletedStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	request := ctx.GetRequest().NamespacedName
+
+	err := ctrl.Get(ctx, request, r.Target)
+	if apierrors.IsNotFound(err) {
+		metrics.ForgetBackupPolicy(request.Namespace, request.Name)
+
+		return Complete()
+	}
+
+	return NextStep()
+}
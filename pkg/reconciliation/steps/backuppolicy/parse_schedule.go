@@ -0,0 +1,35 @@
+package backuppolicy
+
+import (
+	"github.com/robfig/cron/v3"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ParseScheduleStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct {
+	// InvalidPhase is set when schedule can not be parsed
+	InvalidPhase PhaseType
+}
+
+func (r *ParseScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Parse backup schedule"
+}
+
+func (r *ParseScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	policy := ctx.GetTarantoolBackupPolicy()
+
+	schedule, err := cron.ParseStandard(policy.GetSchedule())
+	if err != nil {
+		policy.SetPhase(r.InvalidPhase)
+		policy.SetMessage(err.Error())
+		ctrl.GetEventsRecorder().Event(policy, NewInvalidScheduleEvent(err))
+
+		// Policy stays invalid until schedule is changed.
+		return Complete()
+	}
+
+	policy.SetMessage("")
+	ctx.SetSchedule(schedule)
+
+	return NextStep()
+}
@@ -0,0 +1,73 @@
+package backuppolicy
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/metrics"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// PruneStep removes finished backups which are out of retention of policy.
+// The most recent completed backup is always kept.
+type PruneStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct{}
+
+func (r *PruneStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Prune backups"
+}
+
+func (r *PruneStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	policy := ctx.GetTarantoolBackupPolicy()
+
+	keepLast, keepFor := policy.GetKeepLast(), policy.GetKeepFor()
+	if keepLast == 0 && keepFor == 0 {
+		return NextStep()
+	}
+
+	var (
+		finished      int32
+		pending       bool
+		keptCompleted bool
+	)
+
+	for _, backup := range ctx.GetBackups() {
+		if !backup.IsFinished() {
+			continue
+		}
+
+		finished++
+
+		if backup.IsCompleted() && !keptCompleted {
+			keptCompleted = true
+
+			continue
+		}
+
+		expired := keepFor > 0 && time.Since(finishedAt(backup)) > keepFor
+		exceeded := keepLast > 0 && finished > keepLast
+
+		if !expired && !exceeded {
+			continue
+		}
+
+		deleted, err := ctrl.GetBackupPolicyManager().PruneBackup(ctx, backup)
+		if err != nil {
+			return Error(err)
+		}
+
+		if !deleted {
+			pending = true
+
+			continue
+		}
+
+		metrics.BackupPruned(policy)
+		ctrl.GetEventsRecorder().Event(policy, NewBackupPrunedEvent(backup.GetName()))
+	}
+
+	if pending {
+		return Requeue(10 * time.Second)
+	}
+
+	return NextStep()
+}
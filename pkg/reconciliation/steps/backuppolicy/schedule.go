@@ -0,0 +1,74 @@
+package backuppolicy
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// maxMissedSchedules limits search of the most recent missed schedule time after long downtime.
+const maxMissedSchedules = 1000
+
+// ScheduleStep creates backup when schedule time has come, missed schedule times result in a single backup.
+// A new backup is not created while previous one is running.
+type ScheduleStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct {
+	SuspendedPhase PhaseType
+}
+
+func (r *ScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Schedule backup"
+}
+
+func (r *ScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	policy := ctx.GetTarantoolBackupPolicy()
+	if policy.IsSuspended() {
+		policy.SetPhase(r.SuspendedPhase)
+
+		return NextStep()
+	}
+
+	now := time.Now()
+	schedule := ctx.GetSchedule()
+
+	next := schedule.Next(policy.GetLastScheduleTime())
+	if !next.After(now) {
+		scheduledAt := next
+		for i := 0; i < maxMissedSchedules; i++ {
+			following := schedule.Next(scheduledAt)
+			if following.After(now) {
+				break
+			}
+
+			scheduledAt = following
+		}
+
+		if active := r.getActiveBackup(ctx); active != "" {
+			ctrl.GetEventsRecorder().Event(policy, NewBackupSkippedEvent(active))
+		} else {
+			backup, err := ctrl.GetBackupPolicyManager().CreateBackup(ctx, ctx.GetRelatedCluster(), policy, scheduledAt)
+			if err != nil {
+				return Error(err)
+			}
+
+			ctrl.GetEventsRecorder().Event(policy, NewBackupScheduledEvent(backup.GetName()))
+		}
+
+		policy.SetLastScheduleTime(scheduledAt)
+		next = schedule.Next(now)
+	}
+
+	policy.SetNextScheduleTime(next)
+
+	return NextStep()
+}
+
+func (r *ScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) getActiveBackup(ctx CtxType) string {
+	for _, backup := range ctx.GetBackups() {
+		if !backup.IsFinished() {
+			return backup.GetName()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,20 @@
+package backuppolicy
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SetPhaseStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct {
+	Phase PhaseType
+}
+
+func (r *SetPhaseStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Set backup policy phase"
+}
+
+func (r *SetPhaseStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	ctx.GetTarantoolBackupPolicy().SetPhase(r.Phase)
+
+	return NextStep()
+}
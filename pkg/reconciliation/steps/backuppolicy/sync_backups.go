@@ -0,0 +1,73 @@
+package backuppolicy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/metrics"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// SyncBackupsStep puts backups created by policy into context, the most recent first,
+// and reports their results in status and metrics of policy.
+type SyncBackupsStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct{}
+
+func (r *SyncBackupsStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Sync backups of policy"
+}
+
+func (r *SyncBackupsStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	policy := ctx.GetTarantoolBackupPolicy()
+
+	backups, err := ctrl.GetBackupPolicyManager().ListBackups(ctx, policy)
+	if err != nil {
+		return Error(err)
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		left, right := backups[i].GetCreationTimestamp(), backups[j].GetCreationTimestamp()
+		if !left.Equal(&right) {
+			return right.Before(&left)
+		}
+
+		return backups[i].GetName() > backups[j].GetName()
+	})
+
+	var (
+		active        []string
+		lastSucceeded bool
+		lastFailed    bool
+	)
+
+	for _, backup := range backups {
+		switch {
+		case !backup.IsFinished():
+			active = append(active, backup.GetName())
+		case backup.IsCompleted() && !lastSucceeded:
+			lastSucceeded = true
+
+			policy.SetLastSuccessful(backup.GetName(), backup.GetCompletedAt())
+		case !backup.IsCompleted() && !lastFailed:
+			lastFailed = true
+
+			policy.SetLastFailed(backup.GetName(), backup.GetCreationTimestamp().Time)
+		}
+	}
+
+	policy.SetActive(active)
+	ctx.SetBackups(backups)
+
+	metrics.ObserveBackupPolicy(policy, len(active))
+
+	return NextStep()
+}
+
+// finishedAt returns time when backup was finished, failed backups have no completion time.
+func finishedAt(backup api.TarantoolBackup) time.Time {
+	if completedAt := backup.GetCompletedAt(); !completedAt.IsZero() {
+		return completedAt
+	}
+
+	return backup.GetCreationTimestamp().Time
+}
@@ -0,0 +1,29 @@
+package backuppolicy
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// WaitNextScheduleStep requeues policy at next schedule time, suspended policy is not requeued.
+type WaitNextScheduleStep[PhaseType comparable, PolicyType api.TarantoolBackupPolicyWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType BackupPolicyContext[PolicyType, BackupType], CtrlType BackupPolicyController[PolicyType, BackupType]] struct{}
+
+func (r *WaitNextScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Wait next schedule"
+}
+
+func (r *WaitNextScheduleStep[PhaseType, PolicyType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	policy := ctx.GetTarantoolBackupPolicy()
+	if policy.IsSuspended() {
+		return Complete()
+	}
+
+	delay := time.Until(policy.GetNextScheduleTime())
+	if delay < time.Second {
+		delay = time.Second
+	}
+
+	return Requeue(delay)
+}
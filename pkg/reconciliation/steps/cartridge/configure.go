@@ -1,43 +1,104 @@
 package cartridge
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/tarantool/tarantool-operator/pkg/api"
 	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
 	"github.com/tarantool/tarantool-operator/pkg/utils"
-	"gopkg.in/yaml.v3"
 )
 
-type ConfigureStep[ConfigType api.CartridgeConfig, CtxType CartridgeConfigContext[ConfigType], CtrlType CartridgeConfigController] struct{}
+type ConfigureStep[PhaseType comparable, ConfigType api.CartridgeConfigWithStatus[PhaseType], CtxType CartridgeConfigContext[ConfigType], CtrlType CartridgeConfigController] struct {
+	// DriftedPhase is set when actual config differs from desired one and CartridgeConfig is observe-only
+	DriftedPhase PhaseType
+	// InvalidPhase is set when desired config is rejected by validate_config hooks of application
+	InvalidPhase PhaseType
+}
 
-func (r *ConfigureStep[ConfigType, CtxType, CtrlType]) GetName() string {
+func (r *ConfigureStep[PhaseType, ConfigType, CtxType, CtrlType]) GetName() string {
 	return "Configure cartridge"
 }
 
-func (r *ConfigureStep[ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
-	actualConfig, err := ctrl.GetTopology().GetCartridgeConfig(ctx, ctx.GetLeader())
+func (r *ConfigureStep[PhaseType, ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	config := ctx.GetConfig()
+
+	actualConfig, err := ctrl.GetClusterwideConfig().GetConfig(ctx, ctx.GetLeader())
 	if err != nil {
 		return Error(err)
 	}
 
-	var desiredConfig map[string]interface{}
+	desiredConfig := ctx.GetDesiredConfig()
+
+	changedKeys := utils.DiffMaps(actualConfig, desiredConfig)
+	if len(changedKeys) == 0 {
+		ctx.GetLogger().Info("Nothing to change in config")
+		config.SetDrifted(nil)
+
+		return NextStep()
+	}
 
-	err = yaml.Unmarshal(ctx.GetCartridgeConfig().GetData(), &desiredConfig)
+	hash, err := utils.HashObject(desiredConfig)
 	if err != nil {
 		return Error(err)
 	}
 
-	if utils.IsMapSubset(actualConfig, desiredConfig) {
-		ctx.GetLogger().Info("Nothing to change in config")
+	// The difference is a drift only if desired config was applied before or if operator must not apply it,
+	// otherwise desired config was just changed and not applied yet.
+	drifted := config.IsObserveOnly() || config.GetLastAppliedHash() == hash
+	if drifted {
+		ctx.GetLogger().Info("Config drift detected", "keys", changedKeys)
+		config.SetDrifted(changedKeys)
+		ctrl.GetEventsRecorder().Event(config, NewConfigDriftedEvent(changedKeys))
+	}
 
-		return Complete()
+	if config.IsObserveOnly() {
+		config.SetPhase(r.DriftedPhase)
+
+		return Requeue(config.GetDriftCheckInterval())
+	}
+
+	err = ctrl.GetClusterwideConfig().ValidateConfig(ctx, ctx.GetLeader(), desiredConfig)
+	if errors.Is(err, topology.ErrConfigNotActive) {
+		ctx.GetLogger().Info("Cluster-wide config is not active on leader yet, config is not validated")
+
+		return Requeue(10 * time.Second)
 	}
 
-	err = ctrl.GetTopology().ApplyCartridgeConfig(ctx, ctx.GetLeader(), desiredConfig)
+	if err != nil {
+		var validationErr *topology.ConfigValidationError
+		if errors.As(err, &validationErr) {
+			config.SetPhase(r.InvalidPhase)
+			config.SetError(validationErr.ClassName, validationErr.Err)
+			ctrl.GetEventsRecorder().Event(config, NewInvalidConfigEvent(validationErr.LuaError))
+
+			// Config stays invalid until CartridgeConfig or its sources are changed.
+			return Complete()
+		}
+
+		return Error(err)
+	}
+
+	err = ctrl.GetClusterwideConfig().ApplyConfig(ctx, ctx.GetLeader(), desiredConfig)
 	if err != nil {
 		ctx.GetLogger().Error(err, "Unable to apply cartridge config")
 
+		var luaErr *topology.LuaError
+		if errors.As(err, &luaErr) {
+			config.SetError(luaErr.ClassName, luaErr.Err)
+			ctrl.GetEventsRecorder().Event(config, NewUnableToApplyConfigEvent(luaErr))
+		}
+
 		return Error(err)
 	}
 
+	config.AddHistoryEntry(hash, time.Now())
+	config.SetDrifted(nil)
+
+	if drifted {
+		ctrl.GetEventsRecorder().Event(config, NewConfigDriftRevertedEvent(changedKeys))
+	}
+
 	return NextStep()
 }
@@ -0,0 +1,67 @@
+package cartridge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventUnableToLoadConfig  = "UnableToLoadConfig"
+	EventSectionsConflict    = "SectionsConflict"
+	EventConfigDrifted       = "ConfigDrifted"
+	EventConfigDriftReverted = "ConfigDriftReverted"
+	EventInvalidConfig       = "InvalidConfig"
+	EventUnableToApplyConfig = "UnableToApplyConfig"
+)
+
+func NewUnableToLoadConfigEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventUnableToLoadConfig,
+		Message:   err.Error(),
+	}
+}
+
+func NewSectionsConflictEvent(owner string, sections []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventSectionsConflict,
+		Message:   fmt.Sprintf("Sections %s are already owned by CartridgeConfig %s", strings.Join(sections, ", "), owner),
+	}
+}
+
+func NewConfigDriftedEvent(changedKeys []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventConfigDrifted,
+		Message:   fmt.Sprintf("Actual config differs from desired one, changed keys: %s", strings.Join(changedKeys, ", ")),
+	}
+}
+
+func NewConfigDriftRevertedEvent(changedKeys []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventConfigDriftReverted,
+		Message:   fmt.Sprintf("Desired config reapplied, reverted keys: %s", strings.Join(changedKeys, ", ")),
+	}
+}
+
+func NewInvalidConfigEvent(err *topology.LuaError) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventInvalidConfig,
+		Message:   fmt.Sprintf("Config rejected by application: %s", err.Error()),
+	}
+}
+
+func NewUnableToApplyConfigEvent(err *topology.LuaError) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventUnableToApplyConfig,
+		Message:   err.Error(),
+	}
+}
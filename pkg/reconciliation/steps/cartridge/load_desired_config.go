@@ -0,0 +1,167 @@
+package cartridge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type LoadDesiredConfigStep[ConfigType api.CartridgeConfig, CtxType CartridgeConfigContext[ConfigType], CtrlType CartridgeConfigController] struct{}
+
+func (r *LoadDesiredConfigStep[ConfigType, CtxType, CtrlType]) GetName() string {
+	return "Load desired cartridge config"
+}
+
+func (r *LoadDesiredConfigStep[ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	config := ctx.GetConfig()
+
+	desiredConfig, err := LoadDesiredConfig(ctx, ctrl.GetResourcesManager(), config)
+	if err != nil {
+		ctrl.GetEventsRecorder().Event(config, NewUnableToLoadConfigEvent(err))
+
+		return Error(err)
+	}
+
+	ctx.SetDesiredConfig(desiredConfig)
+
+	return NextStep()
+}
+
+// LoadDesiredConfig merges inline data and all sources of CartridgeConfig into a single config.
+// Inline data goes first, then sources in the order they are listed.
+func LoadDesiredConfig(ctx context.Context, resourcesManager k8s.ResourcesManager, config api.CartridgeConfig) (topology.ClusterwideConfigData, error) {
+	desiredConfig := topology.ClusterwideConfigData{}
+
+	err := yaml.Unmarshal(config.GetData(), &desiredConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse config data: %w", err)
+	}
+
+	for _, source := range config.GetSources() {
+		sections, err := loadSourceSections(ctx, resourcesManager, config.GetNamespace(), source)
+		if err != nil {
+			return nil, err
+		}
+
+		for section, value := range sections {
+			desiredConfig[section] = value
+		}
+	}
+
+	return desiredConfig, nil
+}
+
+func loadSourceSections(
+	ctx context.Context,
+	resourcesManager k8s.ResourcesManager,
+	namespace string,
+	source api.CartridgeConfigSource,
+) (topology.ClusterwideConfigData, error) {
+	if source.GetKind() == "" {
+		return nil, errors.New("source must specify exactly one of configMap or secret")
+	}
+
+	data, err := loadSourceData(ctx, resourcesManager, namespace, source)
+	if err != nil {
+		if apierrors.IsNotFound(err) && source.IsOptional() {
+			return topology.ClusterwideConfigData{}, nil
+		}
+
+		return nil, fmt.Errorf("unable to load %s %s: %w", source.GetKind(), source.GetName(), err)
+	}
+
+	// Keys are taken in sorted order, so result does not depend on order of iteration over data
+	var keys, sectionNames []string
+
+	items := source.GetItems()
+	if len(items) == 0 {
+		for key := range data {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		sectionNames = keys
+	}
+
+	for _, item := range items {
+		keys = append(keys, item.GetKey())
+		sectionNames = append(sectionNames, item.GetSection())
+	}
+
+	sections := topology.ClusterwideConfigData{}
+	sectionKeys := map[string]string{}
+
+	for i, key := range keys {
+		section := sectionNames[i]
+
+		if previous, ok := sectionKeys[section]; ok {
+			return nil, fmt.Errorf("%s %s maps keys %s and %s to the same section %s", source.GetKind(), source.GetName(), previous, key, section)
+		}
+
+		sectionKeys[section] = key
+
+		value, ok := data[key]
+		if !ok {
+			if source.IsOptional() {
+				continue
+			}
+
+			return nil, fmt.Errorf("%s %s has no key %s", source.GetKind(), source.GetName(), key)
+		}
+
+		var sectionData any
+
+		err = yaml.Unmarshal(value, &sectionData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse key %s of %s %s: %w", key, source.GetKind(), source.GetName(), err)
+		}
+
+		sections[section] = sectionData
+	}
+
+	return sections, nil
+}
+
+func loadSourceData(
+	ctx context.Context,
+	resourcesManager k8s.ResourcesManager,
+	namespace string,
+	source api.CartridgeConfigSource,
+) (map[string][]byte, error) {
+	data := map[string][]byte{}
+
+	switch source.GetKind() {
+	case api.CartridgeConfigSourceSecret:
+		secret, err := resourcesManager.GetSecret(ctx, namespace, source.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range secret.Data {
+			data[key] = value
+		}
+	case api.CartridgeConfigSourceConfigMap:
+		cfgMap, err := resourcesManager.GetConfigMap(ctx, namespace, source.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range cfgMap.BinaryData {
+			data[key] = value
+		}
+
+		for key, value := range cfgMap.Data {
+			data[key] = []byte(value)
+		}
+	}
+
+	return data, nil
+}
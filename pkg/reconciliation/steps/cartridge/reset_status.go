@@ -12,7 +12,7 @@ func (r *ResetStatusStep[ConfigType, CtxType, CtrlType]) GetName() string {
 }
 
 func (r *ResetStatusStep[ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
-	ctx.GetCartridgeConfig().ResetStatus()
+	ctx.GetConfig().ResetStatus()
 
 	return NextStep()
 }
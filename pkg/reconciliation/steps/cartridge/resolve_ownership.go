@@ -0,0 +1,118 @@
+package cartridge
+
+import (
+	"sort"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// ResolveOwnershipStep decides which top-level sections are owned by CartridgeConfig.
+// All CartridgeConfigs of the cluster claim their sections in priority order,
+// a CartridgeConfig which declares an already claimed section is rejected entirely and claims nothing.
+type ResolveOwnershipStep[PhaseType comparable, ConfigType api.CartridgeConfigWithStatus[PhaseType], CtxType CartridgeConfigContext[ConfigType], CtrlType CartridgeConfigController] struct {
+	ConflictPhase PhaseType
+}
+
+func (r *ResolveOwnershipStep[PhaseType, ConfigType, CtxType, CtrlType]) GetName() string {
+	return "Resolve ownership of cartridge config sections"
+}
+
+func (r *ResolveOwnershipStep[PhaseType, ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	config := ctx.GetConfig()
+
+	siblings, err := ctrl.GetResourcesManager().GetClusterCartridgeConfigs(ctx, ctx.GetRelatedCluster())
+	if err != nil {
+		return Error(err)
+	}
+
+	SortByPriority(siblings)
+
+	owners := map[string]string{}
+
+	for _, sibling := range siblings {
+		if sibling.GetName() == config.GetName() {
+			break
+		}
+
+		if sibling.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		siblingConfig, err := LoadDesiredConfig(ctx, ctrl.GetResourcesManager(), sibling)
+		if err != nil {
+			ctx.GetLogger().Info("Unable to load sibling config, its sections are ignored", "config", sibling.GetName(), "error", err.Error())
+
+			continue
+		}
+
+		claimSections(owners, sibling.GetName(), sectionNames(siblingConfig))
+	}
+
+	sections := sectionNames(ctx.GetDesiredConfig())
+
+	conflicts := map[string][]string{}
+
+	for _, section := range sections {
+		if owner, ok := owners[section]; ok {
+			conflicts[owner] = append(conflicts[owner], section)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		config.SetPhase(r.ConflictPhase)
+		config.SetOwnedSections(nil)
+
+		for owner, ownerSections := range conflicts {
+			ctrl.GetEventsRecorder().Event(config, NewSectionsConflictEvent(owner, ownerSections))
+		}
+
+		return Complete()
+	}
+
+	config.SetOwnedSections(sections)
+
+	return NextStep()
+}
+
+// SortByPriority orders CartridgeConfigs by priority descending, then by creation time and name ascending.
+func SortByPriority(configs []api.CartridgeConfig) {
+	sort.SliceStable(configs, func(i, j int) bool {
+		a, b := configs[i], configs[j]
+
+		if a.GetPriority() != b.GetPriority() {
+			return a.GetPriority() > b.GetPriority()
+		}
+
+		aCreated, bCreated := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+		if !aCreated.Equal(&bCreated) {
+			return aCreated.Before(&bCreated)
+		}
+
+		return a.GetName() < b.GetName()
+	})
+}
+
+func claimSections(owners map[string]string, owner string, sections []string) {
+	for _, section := range sections {
+		if _, ok := owners[section]; ok {
+			return
+		}
+	}
+
+	for _, section := range sections {
+		owners[section] = owner
+	}
+}
+
+func sectionNames(config map[string]any) []string {
+	sections := make([]string, 0, len(config))
+
+	for section := range config {
+		sections = append(sections, section)
+	}
+
+	sort.Strings(sections)
+
+	return sections
+}
@@ -0,0 +1,17 @@
+package cartridge
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// ScheduleDriftCheckStep requeues CartridgeConfig to compare actual config with desired one later.
+type ScheduleDriftCheckStep[ConfigType api.CartridgeConfig, CtxType CartridgeConfigContext[ConfigType], CtrlType CartridgeConfigController] struct{}
+
+func (r *ScheduleDriftCheckStep[ConfigType, CtxType, CtrlType]) GetName() string {
+	return "Schedule cartridge config drift check"
+}
+
+func (r *ScheduleDriftCheckStep[ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	return Requeue(ctx.GetConfig().GetDriftCheckInterval())
+}
@@ -14,7 +14,7 @@ func (r *SetPhaseStep[PhaseType, ConfigType, CtxType, CtrlType]) GetName() strin
 }
 
 func (r *SetPhaseStep[PhaseType, ConfigType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
-	ctx.GetCartridgeConfig().SetPhase(r.Phase)
+	ctx.GetConfig().SetPhase(r.Phase)
 
 	return NextStep()
 }
@@ -25,7 +25,7 @@ func (r *BootstrapStep[PhaseType, ClusterType, CtxType, CtrlType]) Reconcile(ctx
 		return NextStep()
 	}
 
-	err := ctrl.GetTopology().BootstrapVshard(ctx, ctx.GetLeader())
+	err := ctrl.GetSharding().BootstrapVshard(ctx, ctx.GetLeader())
 	if err != nil {
 		if strings.Contains(err.Error(), "No remotes with role \"vshard-router\" available") ||
 			strings.Contains(err.Error(), "No remotes with role \"vshard-storage\" available") {
@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// BootstrapTarantool3Step bootstraps vshard of Tarantool 3.x cluster from the first running router,
+// config module configures sharding of instances but does not distribute buckets across storages.
+type BootstrapTarantool3Step[ClusterType api.Cluster, CtxType ClusterContext[ClusterType], CtrlType ClusterController] struct{}
+
+func (r *BootstrapTarantool3Step[ClusterType, CtxType, CtrlType]) GetName() string {
+	return "Bootstrap vshard of Tarantool 3.x"
+}
+
+func (r *BootstrapTarantool3Step[ClusterType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetCluster()
+
+	if cluster.GetFlavor() != api.ClusterFlavorTarantool3 || cluster.IsBootstrapped() {
+		return NextStep()
+	}
+
+	roles, err := ctrl.GetResourcesManager().GetClusterRoles(ctx, cluster)
+	if err != nil {
+		return Error(err)
+	}
+
+	hasStorages := false
+
+	var routers []api.Role
+
+	for _, role := range roles {
+		hasStorages = hasStorages || role.IsStorage()
+
+		if utils.SliceContains(role.GetVShardConfig().GetRoles(), "vshard-router") {
+			routers = append(routers, role)
+		}
+	}
+
+	if !hasStorages || len(routers) == 0 {
+		return NextStep()
+	}
+
+	for _, role := range routers {
+		pods, err := ctrl.GetResourcesManager().ListPods(ctx, cluster.GetNamespace(), ctrl.GetLabelsManager().SelectorByRoleName(role))
+		if err != nil {
+			return Error(err)
+		}
+
+		for key := range pods.Items {
+			pod := &pods.Items[key]
+
+			if utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+				continue
+			}
+
+			err = ctrl.GetDeclarativeConfig().BootstrapVShard(ctx, pod)
+			if err != nil {
+				ctrl.GetEventsRecorder().Event(cluster, NewUnableToBootstrapEvent(err))
+
+				return Requeue(10 * time.Second)
+			}
+
+			cluster.MarkBootstrapped()
+			ctrl.GetEventsRecorder().Event(cluster, NewBootstrappedEvent())
+
+			return NextStep()
+		}
+	}
+
+	return Requeue(5 * time.Second)
+}
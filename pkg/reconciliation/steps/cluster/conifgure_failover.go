@@ -29,7 +29,7 @@ func (r *ConfigureFailoverStep[ClusterType, CtxType, CtrlType]) Reconcile(ctx Ct
 		return Error(err)
 	}
 
-	currentParams, err := ctrl.GetTopology().GetFailoverParams(ctx, ctx.GetLeader())
+	currentParams, err := ctrl.GetFailover().GetFailoverParams(ctx, ctx.GetLeader())
 	if err != nil {
 		ctx.GetLogger().Error(
 			err,
@@ -45,7 +45,7 @@ func (r *ConfigureFailoverStep[ClusterType, CtxType, CtrlType]) Reconcile(ctx Ct
 		return NextStep()
 	}
 
-	if err = ctrl.GetTopology().SetFailoverParams(ctx, ctx.GetLeader(), params); err != nil {
+	if err = ctrl.GetFailover().SetFailoverParams(ctx, ctx.GetLeader(), params); err != nil {
 		ctx.GetLogger().Error(err, "failed to enable cluster failover")
 
 		return Error(err)
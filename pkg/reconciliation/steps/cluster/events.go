@@ -8,6 +8,9 @@ import (
 const (
 	EventUnableToBootstrap = "UnableToBootstrap"
 	EventBootstrapped      = "Bootstrapped"
+
+	EventUnableToReloadConfig = "UnableToReloadConfig"
+	EventConfigReloaded       = "ConfigReloaded"
 )
 
 func NewUnableToBootstrapEvent(err error) *events.Event {
@@ -25,3 +28,19 @@ func NewBootstrappedEvent() *events.Event {
 		Message:   "Bootstrapped successfully.",
 	}
 }
+
+func NewUnableToReloadConfigEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventUnableToReloadConfig,
+		Message:   err.Error(),
+	}
+}
+
+func NewConfigReloadedEvent() *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventConfigReloaded,
+		Message:   "Cluster config reloaded on all instances.",
+	}
+}
@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LabelLeaderStep moves topology leader label to the pod of current leader of cluster.
+type LabelLeaderStep[ClusterType api.Cluster, CtxType ClusterContext[ClusterType], CtrlType ClusterController] struct{}
+
+func (r *LabelLeaderStep[ClusterType, CtxType, CtrlType]) GetName() string {
+	return "Label topology leader"
+}
+
+func (r *LabelLeaderStep[ClusterType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetCluster()
+	leader := ctx.GetLeader()
+	label := ctrl.GetLabelsManager().TopologyLeader()
+
+	pods, err := ctrl.GetResourcesManager().ListPods(ctx, cluster.GetNamespace(), labels.SelectorFromSet(map[string]string{
+		ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+		label:                                 "true",
+	}))
+	if err != nil {
+		return Error(err)
+	}
+
+	for key := range pods.Items {
+		pod := &pods.Items[key]
+		if pod.GetName() == leader.GetName() {
+			continue
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		delete(pod.Labels, label)
+
+		err = ctrl.Patch(ctx, pod, patch)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return Error(err)
+		}
+	}
+
+	if leader.GetLabels()[label] != "true" {
+		patch := client.MergeFrom(leader.DeepCopy())
+		leader.SetLabels(utils.MergeMaps(leader.GetLabels(), map[string]string{label: "true"}))
+
+		err = ctrl.Patch(ctx, leader, patch)
+		if err != nil {
+			return Error(err)
+		}
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,72 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// SyncClusterConfigStep renders Tarantool 3.x config of cluster into ConfigMap and reloads it on every running instance.
+// Checksum of config is saved into status once all instances reloaded it, so unchanged config is not reloaded again.
+type SyncClusterConfigStep[ClusterType api.Cluster, CtxType ClusterContext[ClusterType], CtrlType ClusterController] struct{}
+
+func (r *SyncClusterConfigStep[ClusterType, CtxType, CtrlType]) GetName() string {
+	return "Sync cluster config"
+}
+
+func (r *SyncClusterConfigStep[ClusterType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetCluster()
+
+	if cluster.GetFlavor() != api.ClusterFlavorTarantool3 {
+		return NextStep()
+	}
+
+	checksum, err := ctrl.GetResourcesManager().SyncClusterConfig(ctx, cluster)
+	if err != nil {
+		return Error(err)
+	}
+
+	if cluster.GetAppliedConfigHash() == checksum {
+		return NextStep()
+	}
+
+	pods, err := ctrl.GetResourcesManager().ListPods(ctx, cluster.GetNamespace(), ctrl.GetLabelsManager().SelectorByClusterName(cluster))
+	if err != nil {
+		return Error(err)
+	}
+
+	pending := false
+
+	for key := range pods.Items {
+		pod := &pods.Items[key]
+
+		if utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+			pending = true
+
+			continue
+		}
+
+		reloaded, err := ctrl.GetDeclarativeConfig().ReloadConfig(ctx, pod, checksum)
+		if err != nil {
+			ctrl.GetEventsRecorder().Event(cluster, NewUnableToReloadConfigEvent(err))
+
+			return Requeue(10 * time.Second)
+		}
+
+		// File of ConfigMap volume is updated by kubelet with a delay
+		if !reloaded {
+			pending = true
+		}
+	}
+
+	if pending {
+		return Requeue(5 * time.Second)
+	}
+
+	cluster.SetAppliedConfigHash(checksum)
+	ctrl.GetEventsRecorder().Event(cluster, NewConfigReloadedEvent())
+
+	return NextStep()
+}
@@ -0,0 +1,300 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SyncMetricsStep creates metrics Service for each role of cluster and ServiceMonitor selecting them,
+// or PodMonitor when only it is installed, so Prometheus scrapes Cartridge metrics endpoint of every instance.
+// All of them are deleted when metrics are disabled.
+type SyncMetricsStep[ClusterType api.Cluster, CtxType ClusterContext[ClusterType], CtrlType ClusterController] struct{}
+
+func (r *SyncMetricsStep[ClusterType, CtxType, CtrlType]) GetName() string {
+	return "Sync metrics"
+}
+
+func (r *SyncMetricsStep[ClusterType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetCluster()
+	config := cluster.GetMetricsConfig()
+
+	synced := map[string]bool{}
+
+	if config != nil {
+		roles, err := ctrl.GetResourcesManager().GetClusterRoles(ctx, cluster)
+		if err != nil {
+			return Error(err)
+		}
+
+		for _, role := range roles {
+			name, err := r.syncService(ctx, ctrl, cluster, role, config)
+			if err != nil {
+				return Error(err)
+			}
+
+			synced[name] = true
+		}
+	}
+
+	services := &corev1.ServiceList{}
+
+	err := ctrl.List(ctx, services, client.InNamespace(cluster.GetNamespace()), client.MatchingLabels{
+		ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+		ctrl.GetLabelsManager().Metrics():     "true",
+	})
+	if err != nil {
+		return Error(err)
+	}
+
+	for key := range services.Items {
+		svc := &services.Items[key]
+		if synced[svc.GetName()] {
+			continue
+		}
+
+		err = ctrl.Delete(ctx, svc)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return Error(err)
+		}
+	}
+
+	err = r.syncMonitors(ctx, ctrl, cluster, config)
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
+
+func (r *SyncMetricsStep[ClusterType, CtxType, CtrlType]) syncService(
+	ctx CtxType,
+	ctrl CtrlType,
+	cluster ClusterType,
+	role api.Role,
+	config api.MetricsConfig,
+) (string, error) {
+	name := fmt.Sprintf("%s-metrics", role.GetName())
+	saveFunc := ctrl.GetResourcesManager().UpdateObject
+
+	svc, err := ctrl.GetResourcesManager().GetService(ctx, cluster.GetNamespace(), name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return name, err
+		}
+
+		saveFunc = ctrl.GetResourcesManager().CreateObject
+		svc = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: cluster.GetNamespace(),
+			},
+		}
+	}
+
+	changed, err := ctrl.GetResourcesManager().ControlObject(cluster, svc)
+	if err != nil {
+		return name, err
+	}
+
+	labels := map[string]string{
+		ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+		ctrl.GetLabelsManager().RoleName():    role.GetName(),
+		ctrl.GetLabelsManager().Metrics():     "true",
+	}
+	if !cmp.Equal(svc.GetLabels(), labels) {
+		svc.SetLabels(labels)
+		changed = true
+	}
+
+	selector := map[string]string{
+		ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+		ctrl.GetLabelsManager().RoleName():    role.GetName(),
+	}
+	if !cmp.Equal(svc.Spec.Selector, selector) {
+		svc.Spec.Selector = selector
+		changed = true
+	}
+
+	ports := []corev1.ServicePort{
+		{
+			Name:       "metrics",
+			Port:       config.GetPort(),
+			TargetPort: intstr.FromInt(int(config.GetPort())),
+			Protocol:   "TCP",
+		},
+	}
+	if !cmp.Equal(svc.Spec.Ports, ports) {
+		svc.Spec.Ports = ports
+		changed = true
+	}
+
+	if changed {
+		err = saveFunc(ctx, svc)
+		if err != nil {
+			return name, err
+		}
+	}
+
+	return name, nil
+}
+
+// syncMonitors syncs ServiceMonitor selecting metrics Services, when only PodMonitor CRD of Prometheus Operator
+// is installed PodMonitor selecting pods of cluster is synced instead.
+func (r *SyncMetricsStep[ClusterType, CtxType, CtrlType]) syncMonitors(
+	ctx CtxType,
+	ctrl CtrlType,
+	cluster ClusterType,
+	config api.MetricsConfig,
+) error {
+	var labels map[string]string
+
+	var serviceMonitorSpec, podMonitorSpec map[string]interface{}
+
+	if config != nil {
+		labels = utils.MergeMaps(config.GetLabels(), map[string]string{
+			ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+		})
+
+		endpoint := map[string]interface{}{
+			"port": "metrics",
+			"path": config.GetPath(),
+		}
+		podEndpoint := map[string]interface{}{
+			"targetPort": int64(config.GetPort()),
+			"path":       config.GetPath(),
+		}
+
+		if config.GetInterval() != "" {
+			endpoint["interval"] = config.GetInterval()
+			podEndpoint["interval"] = config.GetInterval()
+		}
+
+		serviceMonitorSpec = map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+					ctrl.GetLabelsManager().Metrics():     "true",
+				},
+			},
+			"endpoints": []interface{}{endpoint},
+			"podTargetLabels": []interface{}{
+				ctrl.GetLabelsManager().ClusterName(),
+				ctrl.GetLabelsManager().RoleName(),
+				ctrl.GetLabelsManager().ReplicasetName(),
+			},
+		}
+		podMonitorSpec = map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+				},
+			},
+			"podMetricsEndpoints": []interface{}{podEndpoint},
+			"podTargetLabels": []interface{}{
+				ctrl.GetLabelsManager().ClusterName(),
+				ctrl.GetLabelsManager().RoleName(),
+				ctrl.GetLabelsManager().ReplicasetName(),
+			},
+		}
+	}
+
+	installed, err := r.syncMonitor(ctx, ctrl, cluster, utils.NewServiceMonitor(), labels, serviceMonitorSpec)
+	if err != nil {
+		return err
+	}
+
+	if installed {
+		podMonitorSpec = nil
+	}
+
+	_, err = r.syncMonitor(ctx, ctrl, cluster, utils.NewPodMonitor(), labels, podMonitorSpec)
+
+	return err
+}
+
+// syncMonitor creates or updates monitor named after cluster, the monitor is deleted when spec is nil.
+// It returns false when CRD of monitor is not installed.
+func (r *SyncMetricsStep[ClusterType, CtxType, CtrlType]) syncMonitor(
+	ctx CtxType,
+	ctrl CtrlType,
+	cluster ClusterType,
+	monitor *unstructured.Unstructured,
+	labels map[string]string,
+	spec map[string]interface{},
+) (bool, error) {
+	err := ctrl.Get(ctx, types.NamespacedName{Namespace: cluster.GetNamespace(), Name: cluster.GetName()}, monitor)
+	exists := err == nil
+
+	switch {
+	case meta.IsNoMatchError(err):
+		// Prometheus Operator is not installed, metrics Services are left to be scraped by other means
+		return false, nil
+	case err != nil && !apierrors.IsNotFound(err):
+		return true, err
+	}
+
+	if spec == nil {
+		if !exists {
+			return true, nil
+		}
+
+		err = ctrl.Delete(ctx, monitor)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return true, err
+		}
+
+		return true, nil
+	}
+
+	if !exists {
+		monitor.SetName(cluster.GetName())
+		monitor.SetNamespace(cluster.GetNamespace())
+	}
+
+	changed, err := ctrl.GetResourcesManager().ControlObject(cluster, monitor)
+	if err != nil {
+		return true, err
+	}
+
+	if !cmp.Equal(monitor.GetLabels(), labels) {
+		monitor.SetLabels(labels)
+		changed = true
+	}
+
+	// Only fields set by operator are compared, fields defaulted by API server would cause update on every reconcile
+	actual, _, _ := unstructured.NestedMap(monitor.Object, "spec")
+	for field, value := range spec {
+		if utils.IsSubset(actual[field], value) {
+			continue
+		}
+
+		err = unstructured.SetNestedField(monitor.Object, value, "spec", field)
+		if err != nil {
+			return true, err
+		}
+
+		changed = true
+	}
+
+	if !exists {
+		return true, ctrl.GetResourcesManager().CreateObject(ctx, monitor)
+	}
+
+	if changed {
+		return true, ctrl.GetResourcesManager().UpdateObject(ctx, monitor)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// CompleteForFlavor finishes reconciliation after given steps when related cluster has given flavor,
+// it cuts off the rest of pipeline which is specific to another flavor.
+func CompleteForFlavor[CtxType Context, CtrlType Controller](
+	flavor api.ClusterFlavor,
+	steps ...Step[CtxType, CtrlType],
+) *CompleteForFlavorStep[CtxType, CtrlType] {
+	return &CompleteForFlavorStep[CtxType, CtrlType]{
+		Flavor: flavor,
+		Steps:  steps,
+	}
+}
+
+type CompleteForFlavorStep[CtxType Context, CtrlType Controller] struct {
+	Flavor api.ClusterFlavor
+	Steps  []Step[CtxType, CtrlType]
+}
+
+func (r *CompleteForFlavorStep[CtxType, CtrlType]) GetName() string {
+	return "Complete for " + string(r.Flavor) + " flavor"
+}
+
+func (r *CompleteForFlavorStep[CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetRelatedCluster()
+	if cluster == nil || cluster.GetFlavor() != r.Flavor {
+		return NextStep()
+	}
+
+	for _, step := range r.Steps {
+		res, err := step.Reconcile(ctx, ctrl)
+		if err != nil || res != nil {
+			return res, err
+		}
+	}
+
+	return Complete()
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"time"
+
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+func WaitForRestore[CtxType Context, CtrlType Controller]() *WaitForRestoreStep[CtxType, CtrlType] {
+	return &WaitForRestoreStep[CtxType, CtrlType]{}
+}
+
+// WaitForRestoreStep holds reconciliation while volumes of the cluster are being restored from backup.
+type WaitForRestoreStep[CtxType Context, CtrlType Controller] struct{}
+
+func (r *WaitForRestoreStep[CtxType, CtrlType]) GetName() string {
+	return "Wait for restore"
+}
+
+func (r *WaitForRestoreStep[CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	restoring, err := ctrl.GetResourcesManager().IsClusterRestoring(ctx, ctx.GetRelatedCluster())
+	if err != nil {
+		return Error(err)
+	}
+
+	if restoring {
+		ctx.GetLogger().Info("Cluster is being restored from backup, waiting")
+
+		return Requeue(10 * time.Second)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,32 @@
+package restore
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// CheckClusterStep fails restore when the cluster was bootstrapped before restore started,
+// data of running cluster is never overwritten.
+type CheckClusterStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct {
+	FailedPhase PhaseType
+}
+
+func (r *CheckClusterStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Check cluster is not bootstrapped"
+}
+
+func (r *CheckClusterStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	restore := ctx.GetTarantoolRestore()
+
+	if restore.IsStarted() || !ctx.GetRelatedCluster().IsBootstrapped() {
+		return NextStep()
+	}
+
+	message := "cluster is already bootstrapped, restore is possible only into a new cluster"
+
+	restore.SetPhase(r.FailedPhase)
+	restore.SetMessage(message)
+	ctrl.GetEventsRecorder().Event(restore, NewRestoreFailedEvent(message))
+
+	return Complete()
+}
@@ -0,0 +1,68 @@
+package restore
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+type DownloadStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct {
+	// FailedPhase is set when any download job is failed
+	FailedPhase PhaseType
+}
+
+func (r *DownloadStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Download backup files"
+}
+
+func (r *DownloadStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	restore := ctx.GetTarantoolRestore()
+
+	var (
+		pending bool
+		failed  []string
+	)
+
+	for _, pod := range restore.GetInstancePods() {
+		if restore.IsInstanceRestored(pod) {
+			continue
+		}
+
+		job, err := ctrl.GetRestoreManager().EnsureDownloadJob(ctx, restore, ctx.GetTarantoolBackup(), pod)
+		if err != nil {
+			return Error(err)
+		}
+
+		switch {
+		case utils.IsJobComplete(job):
+			restore.SetInstanceRestored(pod)
+		case utils.IsJobFailed(job):
+			failed = append(failed, job.GetName())
+		default:
+			pending = true
+		}
+	}
+
+	if len(failed) > 0 {
+		message := fmt.Sprintf("download jobs failed: %s", strings.Join(failed, ", "))
+
+		restore.SetPhase(r.FailedPhase)
+		restore.SetMessage(message)
+		ctrl.GetEventsRecorder().Event(restore, NewRestoreFailedEvent(message))
+
+		return Complete()
+	}
+
+	if pending {
+		return Requeue(10 * time.Second)
+	}
+
+	restore.SetCompletedAt(time.Now())
+	ctrl.GetEventsRecorder().Event(restore, NewRestoreCompletedEvent(ctx.GetTarantoolBackup().GetName()))
+
+	return NextStep()
+}
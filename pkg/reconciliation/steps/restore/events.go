@@ -0,0 +1,29 @@
+package restore
+
+import (
+	"fmt"
+
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventRestoreCompleted = "RestoreCompleted"
+	EventRestoreFailed    = "RestoreFailed"
+)
+
+func NewRestoreCompletedEvent(backup string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventRestoreCompleted,
+		Message:   fmt.Sprintf("Volumes populated from backup %s, cluster can be started.", backup),
+	}
+}
+
+func NewRestoreFailedEvent(message string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventRestoreFailed,
+		Message:   fmt.Sprintf("Restore failed: %s", message),
+	}
+}
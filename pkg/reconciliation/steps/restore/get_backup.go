@@ -0,0 +1,51 @@
+package restore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// GetBackupStep waits until backup is completed and puts it into context.
+type GetBackupStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct {
+	// FailedPhase is set when backup is failed
+	FailedPhase PhaseType
+}
+
+func (r *GetBackupStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Get backup"
+}
+
+func (r *GetBackupStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	restore := ctx.GetTarantoolRestore()
+
+	backup, err := ctrl.GetRestoreManager().GetBackup(ctx, restore)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return Requeue(10 * time.Second)
+		}
+
+		return Error(err)
+	}
+
+	if backup.IsFinished() && !backup.IsCompleted() {
+		message := fmt.Sprintf("backup %s is failed", backup.GetName())
+
+		restore.SetPhase(r.FailedPhase)
+		restore.SetMessage(message)
+		ctrl.GetEventsRecorder().Event(restore, NewRestoreFailedEvent(message))
+
+		return Complete()
+	}
+
+	if !backup.IsCompleted() {
+		return Requeue(10 * time.Second)
+	}
+
+	ctx.SetTarantoolBackup(backup)
+
+	return NextStep()
+}
@@ -0,0 +1,21 @@
+package restore
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type PrepareVolumesStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct{}
+
+func (r *PrepareVolumesStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Prepare volumes"
+}
+
+func (r *PrepareVolumesStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	err := ctrl.GetRestoreManager().PrepareVolumes(ctx, ctx.GetRelatedCluster(), ctx.GetTarantoolRestore(), ctx.GetTarantoolBackup())
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,20 @@
+package restore
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SetPhaseStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct {
+	Phase PhaseType
+}
+
+func (r *SetPhaseStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Set restore phase"
+}
+
+func (r *SetPhaseStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	ctx.GetTarantoolRestore().SetPhase(r.Phase)
+
+	return NextStep()
+}
@@ -0,0 +1,21 @@
+package restore
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+// SkipFinishedStep stops reconciliation of restore which is already completed or failed.
+type SkipFinishedStep[PhaseType comparable, RestoreType api.TarantoolRestoreWithStatus[PhaseType], BackupType api.TarantoolBackup, CtxType RestoreContext[RestoreType, BackupType], CtrlType RestoreController[RestoreType, BackupType]] struct{}
+
+func (r *SkipFinishedStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) GetName() string {
+	return "Skip finished restore"
+}
+
+func (r *SkipFinishedStep[PhaseType, RestoreType, BackupType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	if ctx.GetTarantoolRestore().IsFinished() {
+		return Complete()
+	}
+
+	return NextStep()
+}
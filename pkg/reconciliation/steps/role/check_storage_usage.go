@@ -0,0 +1,142 @@
+package role
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// CheckStorageUsageStep compares memtx and vinyl usage of running instances of role with thresholds of storage policy,
+// reports instances exceeding them and adds a replicaset if policy allows it. Buckets are moved to the new
+// replicaset by vshard rebalancer, so the next replicaset is not added until cooldown is passed.
+// Instances which do not report usage are skipped, scale out is blocked while maxReplicasets is not greater
+// than number of replicasets, both are reported in events and status of role.
+type CheckStorageUsageStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *CheckStorageUsageStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Check storage usage"
+}
+
+func (r *CheckStorageUsageStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	policy := role.GetStoragePolicy()
+	if policy == nil {
+		role.ClearStorageUsage()
+
+		return NextStep()
+	}
+
+	pods, err := ctrl.GetResourcesManager().ListPods(ctx, role.GetNamespace(), ctrl.GetLabelsManager().SelectorByRoleName(role))
+	if err != nil {
+		return Error(err)
+	}
+
+	var (
+		exceeded []string
+		failed   []string
+	)
+
+	for key := range pods.Items {
+		pod := &pods.Items[key]
+		if !utils.IsPodRunning(pod) || utils.IsPodDeleting(pod) {
+			continue
+		}
+
+		usage, err := ctrl.GetStorage().GetStorageUsage(ctx, pod)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s)", pod.GetName(), err))
+
+			continue
+		}
+
+		if description := r.exceededUsage(policy, usage); description != "" {
+			exceeded = append(exceeded, fmt.Sprintf("%s (%s)", pod.GetName(), description))
+		}
+	}
+
+	if len(failed) > 0 {
+		ctrl.GetEventsRecorder().Event(role, NewStorageUsageUnknownEvent(failed))
+	}
+
+	role.SetStorageUsageHigh(exceeded)
+
+	replicasets := role.GetReplicasets()
+
+	reason, message := r.scaleOutBlocked(policy, replicasets)
+	if role.SetScaleOutBlocked(reason, message) && reason != "" {
+		ctrl.GetEventsRecorder().Event(role, NewScaleOutBlockedEvent(message))
+	}
+
+	if len(exceeded) == 0 {
+		return Requeue(policy.GetInterval())
+	}
+
+	ctrl.GetEventsRecorder().Event(role, NewStorageUsageHighEvent(exceeded))
+
+	if reason != "" || !policy.IsScaleOut() || time.Since(role.GetLastScaleOutTime()) < policy.GetCooldown() {
+		return Requeue(policy.GetInterval())
+	}
+
+	// Role is updated through a copy, so status collected during this reconciliation is not overwritten by response.
+	updated, _ := role.DeepCopyObject().(RoleType)
+	updated.SetReplicasets(replicasets + 1)
+
+	err = ctrl.Update(ctx, updated)
+	if err != nil {
+		return Error(err)
+	}
+
+	role.SetReplicasets(replicasets + 1)
+	role.SetResourceVersion(updated.GetResourceVersion())
+	role.SetLastScaleOut(time.Now(), replicasets+1)
+
+	ctrl.GetEventsRecorder().Event(role, NewReplicasetAddedEvent(replicasets, replicasets+1, exceeded))
+
+	return Requeue(policy.GetInterval())
+}
+
+// scaleOutBlocked returns reason and message of blocked ScaleOut action or empty reason,
+// maxReplicasets must be greater than number of replicasets to allow adding one.
+func (r *CheckStorageUsageStep[RoleType, CtxType, CtrlType]) scaleOutBlocked(policy api.StoragePolicy, replicasets int32) (string, string) {
+	if !policy.IsScaleOut() {
+		return "", ""
+	}
+
+	if policy.GetMaxReplicasets() <= 0 {
+		return api.ScaleOutMaxNotSet, "spec.storagePolicy.maxReplicasets is required by ScaleOut action"
+	}
+
+	if replicasets >= policy.GetMaxReplicasets() {
+		return api.ScaleOutMaxReached, fmt.Sprintf(
+			"Role has %d replicasets, spec.storagePolicy.maxReplicasets %d is reached",
+			replicasets,
+			policy.GetMaxReplicasets(),
+		)
+	}
+
+	return "", ""
+}
+
+// exceededUsage returns description of usage above thresholds or empty string.
+func (r *CheckStorageUsageStep[RoleType, CtxType, CtrlType]) exceededUsage(policy api.StoragePolicy, usage *topology.StorageUsage) string {
+	if usage.MemtxLimit > 0 {
+		percent := usage.MemtxUsed * 100 / usage.MemtxLimit
+		if percent >= int64(policy.GetMemtxThreshold()) {
+			return fmt.Sprintf("memtx %d%%", percent)
+		}
+	}
+
+	if limit := policy.GetVinylDiskLimit(); limit > 0 {
+		percent := usage.VinylDisk * 100 / limit
+		if percent >= int64(policy.GetVinylThreshold()) {
+			return fmt.Sprintf("vinyl %d%%", percent)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,173 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ConfigureElectionStep applies Raft params of cluster and election mode of role with box.cfg on every running
+// instance when cluster uses "raft" failover mode. Raft term and leader of each replicaset are reported in role status.
+// Roles which replicasets can not elect a leader are reported in event and are not configured until spec is changed.
+type ConfigureElectionStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ConfigureElectionStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Configure election"
+}
+
+func (r *ConfigureElectionStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+	failover := ctx.GetRelatedCluster().GetFailoverConfig()
+
+	if failover.GetMode() != api.FailoverModeRaft {
+		return NextStep()
+	}
+
+	err := r.validate(role, failover.GetRaftConfig())
+	if err != nil {
+		ctrl.GetEventsRecorder().Event(role, NewInvalidElectionConfigEvent(err))
+
+		return Complete()
+	}
+
+	params := r.electionParams(role, failover.GetRaftConfig())
+	pending := false
+
+	for ordinal := int32(0); ordinal < role.GetReplicasets(); ordinal++ {
+		replicasetName, err := role.GetReplicasetName(ordinal)
+		if err != nil {
+			return Error(err)
+		}
+
+		podList, err := ctrl.GetResourcesManager().ListPods(
+			ctx,
+			role.GetNamespace(),
+			ctrl.GetLabelsManager().SelectorByReplicasetOrdinal(role, ordinal),
+		)
+		if err != nil {
+			return Error(err)
+		}
+
+		if int32(len(podList.Items)) < role.GetReplicas() {
+			pending = true
+		}
+
+		observed := false
+		term := int64(0)
+		leader := ""
+
+		for key := range podList.Items {
+			pod := &podList.Items[key]
+			if utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+				pending = true
+
+				continue
+			}
+
+			state, err := ctrl.GetElection().ConfigureElection(ctx, pod, params)
+			if err != nil {
+				var notSupportedErr *topology.RaftNotSupportedError
+				if errors.As(err, &notSupportedErr) {
+					ctrl.GetEventsRecorder().Event(role, NewRaftNotSupportedEvent(pod.GetName(), notSupportedErr))
+
+					return Complete()
+				}
+
+				ctrl.GetEventsRecorder().Event(role, NewUnableToConfigureElectionEvent(pod.GetName(), err))
+
+				return Requeue(10 * time.Second)
+			}
+
+			observed = true
+
+			if state.Term > term {
+				term = state.Term
+			}
+
+			if state.State == "leader" {
+				leader = pod.GetName()
+			}
+		}
+
+		if !observed {
+			continue
+		}
+
+		role.SetReplicasetElection(replicasetName, term, leader)
+
+		// Election is in progress, status is refreshed until leader is known
+		if leader == "" && role.GetElectionMode() == api.ElectionModeCandidate {
+			pending = true
+		}
+	}
+
+	if pending {
+		return Requeue(5 * time.Second)
+	}
+
+	return NextStep()
+}
+
+// validate checks that every replicaset of role has enough candidates to elect a leader. Election mode applies
+// to all instances of role, so replicasets of voters have no candidates at all, and leader is elected only by
+// votes of synchro quorum of instances, synchro quorum of role takes precedence over quorum of cluster.
+func (r *ConfigureElectionStep[RoleType, CtxType, CtrlType]) validate(role RoleType, raft api.FailoverRaftConfig) error {
+	switch role.GetElectionMode() {
+	case api.ElectionModeOff:
+		return nil
+	case api.ElectionModeVoter:
+		return errors.New("replicasets have no candidates, all instances of role are voters")
+	}
+
+	quorum, ok, err := synchroQuorum(role)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		if raft == nil || raft.GetSynchroQuorum() == nil || raft.GetSynchroQuorum().Type != intstr.Int {
+			return nil
+		}
+
+		quorum = raft.GetSynchroQuorum().IntVal
+	}
+
+	if quorum > role.GetReplicas() {
+		return fmt.Errorf("replicasets have %d candidates, synchro quorum %d can not be reached", role.GetReplicas(), quorum)
+	}
+
+	return nil
+}
+
+func (r *ConfigureElectionStep[RoleType, CtxType, CtrlType]) electionParams(
+	role RoleType,
+	raft api.FailoverRaftConfig,
+) *topology.ElectionParams {
+	params := &topology.ElectionParams{
+		Mode: role.GetElectionMode(),
+	}
+
+	if raft != nil {
+		params.Timeout = raft.GetElectionTimeout().Seconds()
+	}
+
+	// Synchro quorum of role takes precedence, it is the same as applied by SetReplicationParamsStep
+	if quorum, ok, _ := synchroQuorum(role); ok {
+		params.SynchroQuorum = quorum
+	} else if raft != nil && raft.GetSynchroQuorum() != nil {
+		quorum := raft.GetSynchroQuorum()
+		if quorum.Type == intstr.Int {
+			params.SynchroQuorum = quorum.IntValue()
+		} else {
+			params.SynchroQuorum = quorum.StrVal
+		}
+	}
+
+	return params
+}
@@ -0,0 +1,212 @@
+package role
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ConfigureReplicationStep sets box.cfg replication of instances without Cartridge to all peers of their StatefulSet.
+// Pod labeled as replicaset master stays writable while it is running. Master is switched only when the old master
+// is unreachable, to the first ready replica which has applied all changes known by other replicas, preferably
+// in primary zone of role. Replicas are made read only before master is made writable.
+type ConfigureReplicationStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ConfigureReplicationStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Configure replication"
+}
+
+func (r *ConfigureReplicationStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+	cluster := ctx.GetRelatedCluster()
+	masters := map[string]bool{}
+	pending := false
+
+	for ordinal := int32(0); ordinal < role.GetReplicasets(); ordinal++ {
+		selector := ctrl.GetLabelsManager().SelectorByReplicasetOrdinal(role, ordinal)
+
+		stsList, err := ctrl.GetResourcesManager().ListStatefulSets(ctx, role.GetNamespace(), selector)
+		if err != nil {
+			return Error(err)
+		}
+
+		if len(stsList.Items) == 0 {
+			pending = true
+
+			continue
+		}
+
+		podList, err := ctrl.GetResourcesManager().ListPods(ctx, role.GetNamespace(), selector)
+		if err != nil {
+			return Error(err)
+		}
+
+		podsByName := map[string]*v1.Pod{}
+		for key := range podList.Items {
+			podsByName[podList.Items[key].GetName()] = &podList.Items[key]
+		}
+
+		// Peers are ordered by ordinals of pods, URIs are the same as advertised by instances
+		uris := ctrl.GetReplicasetsManger().GetReplicationURIs(cluster, &stsList.Items[0])
+
+		var pods []*v1.Pod
+
+		for _, uri := range uris {
+			pod, ok := podsByName[utils.PodNameFromURI(uri)]
+			if !ok || utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+				pending = true
+
+				continue
+			}
+
+			pods = append(pods, pod)
+		}
+
+		master := r.selectMaster(ctx, ctrl, role, podList.Items, pods)
+		if master == nil {
+			// Label of the old master is kept, so it is not switched until it becomes unreachable
+			if oldMaster := r.labeledMaster(ctrl, podList.Items); oldMaster != nil {
+				masters[oldMaster.GetName()] = true
+			}
+
+			pending = true
+
+			continue
+		}
+
+		masters[master.GetName()] = true
+
+		// Replicas go first, so there is no moment when two instances of replicaset are writable
+		var replicas []*v1.Pod
+
+		for _, pod := range pods {
+			if pod != master {
+				replicas = append(replicas, pod)
+			}
+		}
+
+		for _, pod := range append(replicas, master) {
+			applied, err := ctrl.GetReplication().ConfigureReplication(ctx, pod, uris, pod != master)
+			if err != nil {
+				ctrl.GetEventsRecorder().Event(role, NewUnableToConfigureReplicationEvent(pod.GetName(), err))
+
+				return Requeue(10 * time.Second)
+			}
+
+			if !applied {
+				pending = true
+			}
+		}
+	}
+
+	err := ctrl.GetReplicasetsManger().SetMasterLabels(ctx, role, masters)
+	if err != nil {
+		return Error(err)
+	}
+
+	if pending {
+		return Requeue(5 * time.Second)
+	}
+
+	return NextStep()
+}
+
+// selectMaster returns running pod labeled as master. Otherwise, when the old master is unreachable, it returns
+// the first ready pod with vclock covering vclocks of other ready pods, placed in primary zone of role if possible,
+// so no changes applied by replicas are lost. Switch of master from unavailable pod is reported in event.
+func (r *ConfigureReplicationStep[RoleType, CtxType, CtrlType]) selectMaster(
+	ctx CtxType,
+	ctrl CtrlType,
+	role RoleType,
+	all []v1.Pod,
+	running []*v1.Pod,
+) *v1.Pod {
+	label := ctrl.GetLabelsManager().ReplicasetMaster()
+
+	for _, pod := range running {
+		if pod.GetLabels()[label] == "true" {
+			return pod
+		}
+	}
+
+	oldMaster := r.labeledMaster(ctrl, all)
+
+	// Master which is not running may still accept writes, e.g. while its pod is terminating
+	if oldMaster != nil {
+		_, err := ctrl.GetReplication().GetVClock(ctx, oldMaster)
+		if err == nil {
+			ctx.GetLogger().Info("Master is not running, but reachable, it is not switched", "pod", oldMaster.GetName())
+
+			return nil
+		}
+	}
+
+	var (
+		candidates []*v1.Pod
+		vclocks    []topology.VClock
+	)
+
+	for _, pod := range running {
+		if !utils.IsPodReady(pod) {
+			continue
+		}
+
+		vclock, err := ctrl.GetReplication().GetVClock(ctx, pod)
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, pod)
+		vclocks = append(vclocks, vclock)
+	}
+
+	var caughtUp []*v1.Pod
+
+	for i, pod := range candidates {
+		covers := true
+		for j := range candidates {
+			covers = covers && vclocks[i].Covers(vclocks[j])
+		}
+
+		if covers {
+			caughtUp = append(caughtUp, pod)
+		}
+	}
+
+	if len(caughtUp) == 0 {
+		return nil
+	}
+
+	master := caughtUp[0]
+
+	if zone := role.GetPrimaryZone(); zone != "" {
+		for _, pod := range caughtUp {
+			if role.GetInstanceZone(pod.GetName()) == zone {
+				master = pod
+
+				break
+			}
+		}
+	}
+
+	if oldMaster != nil {
+		ctrl.GetEventsRecorder().Event(role, NewMasterSwitchedEvent(oldMaster.GetName(), master.GetName()))
+	}
+
+	return master
+}
+
+// labeledMaster returns pod labeled as master of replicaset.
+func (r *ConfigureReplicationStep[RoleType, CtxType, CtrlType]) labeledMaster(ctrl CtrlType, all []v1.Pod) *v1.Pod {
+	for key := range all {
+		if all[key].GetLabels()[ctrl.GetLabelsManager().ReplicasetMaster()] == "true" {
+			return &all[key]
+		}
+	}
+
+	return nil
+}
@@ -16,7 +16,7 @@ func (r *ConfigureVShardRolesStep[RoleType, CtxType, CtrlType]) GetName() string
 
 func (r *ConfigureVShardRolesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
 	role := ctx.GetRole()
-	topologyClient := ctrl.GetTopology()
+	replicasetConfig := ctrl.GetReplicasetConfig()
 
 	allRolesConfigured := true
 
@@ -37,7 +37,7 @@ func (r *ConfigureVShardRolesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx Ct
 				continue
 			}
 
-			hierarchy, err := topologyClient.GetRolesHierarchy(ctx, ctx.GetLeader())
+			hierarchy, err := replicasetConfig.GetRolesHierarchy(ctx, ctx.GetLeader())
 			if err != nil {
 				allRolesConfigured = false
 
@@ -46,7 +46,7 @@ func (r *ConfigureVShardRolesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx Ct
 
 			replicasetUUID := ctrl.GetReplicasetsManger().GetReplicasetUUID(role, stsOrdinal)
 
-			actualRoles, err := topologyClient.GetReplicasetRoles(ctx, ctx.GetLeader(), replicasetUUID)
+			actualRoles, err := replicasetConfig.GetReplicasetRoles(ctx, ctx.GetLeader(), replicasetUUID)
 			if err != nil {
 				allRolesConfigured = false
 
@@ -57,7 +57,7 @@ func (r *ConfigureVShardRolesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx Ct
 			desiredRoles := vshardConfig.GetRoles()
 
 			if !utils.IsVShardRolesEquals(actualRoles, desiredRoles, hierarchy) {
-				err = topologyClient.SetReplicasetRoles(ctx, ctx.GetLeader(), replicasetUUID, vshardConfig.GetRoles())
+				err = replicasetConfig.SetReplicasetRoles(ctx, ctx.GetLeader(), replicasetUUID, vshardConfig.GetRoles())
 				if err != nil {
 					allRolesConfigured = false
 
@@ -58,7 +58,7 @@ func (r *EnsureCartridgeReadyStep[RoleType, CtxType, CtrlType]) IsCartridgeReady
 			continue
 		}
 
-		started, err := ctrl.GetTopology().IsCartridgeStarted(ctx, pod)
+		started, err := ctrl.GetMembership().IsStarted(ctx, pod)
 		if err != nil {
 			return false, err
 		}
@@ -1,13 +1,39 @@
 package role
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/tarantool/tarantool-operator/pkg/events"
 	"github.com/tarantool/tarantool-operator/pkg/topology"
 	corev1 "k8s.io/api/core/v1"
 )
 
 const (
-	EventTypeWrongVShardRoles = "WrongVShardRoles"
+	EventTypeWrongVShardRoles      = "WrongVShardRoles"
+	EventTypeVolumeNotExpandable   = "VolumeNotExpandable"
+	EventTypeStatefulSetRecreated  = "StatefulSetRecreated"
+	EventTypeReplicasInSingleZone  = "ReplicasInSingleZone"
+	EventTypeReplicasetRemoved     = "ReplicasetRemoved"
+	EventTypeReplicasetNotExpelled = "ReplicasetNotExpelled"
+	EventTypeScaleDownBlocked      = "ScaleDownBlocked"
+	EventTypeStorageUsageHigh      = "StorageUsageHigh"
+	EventTypeReplicasetAdded       = "ReplicasetAdded"
+	EventTypeScaleOutBlocked       = "ScaleOutBlocked"
+	EventTypeStorageUsageUnknown   = "StorageUsageUnknown"
+	EventTypeInvalidTLSSecret      = "InvalidTLSSecret"
+
+	EventTypeCertManagerNotInstalled = "CertManagerNotInstalled"
+
+	EventTypeUnableToConfigureReplication = "UnableToConfigureReplication"
+	EventTypeMasterSwitched               = "MasterSwitched"
+
+	EventTypeInvalidElectionConfig     = "InvalidElectionConfig"
+	EventTypeRaftNotSupported          = "RaftNotSupported"
+	EventTypeUnableToConfigureElection = "UnableToConfigureElection"
+
+	EventTypeInvalidReplicationConfig     = "InvalidReplicationConfig"
+	EventTypeUnableToSetReplicationParams = "UnableToSetReplicationParams"
 )
 
 func NewWrongVShardRolesEvent(err *topology.UnknownRoleError) *events.Event {
@@ -17,3 +43,163 @@ func NewWrongVShardRolesEvent(err *topology.UnknownRoleError) *events.Event {
 		Message:   err.Error(),
 	}
 }
+
+func NewVolumeNotExpandableEvent(claim string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeVolumeNotExpandable,
+		Message:   fmt.Sprintf("Volume %s can not be expanded, its StorageClass does not allow volume expansion.", claim),
+	}
+}
+
+func NewStatefulSetRecreatedEvent(sts string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventTypeStatefulSetRecreated,
+		Message:   fmt.Sprintf("StatefulSet %s is deleted leaving pods orphaned to be created with expanded volume claim templates.", sts),
+	}
+}
+
+func NewReplicasInSingleZoneEvent(replicaset, zone string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeReplicasInSingleZone,
+		Message:   fmt.Sprintf("All replicas of replicaset %s are placed in zone %s.", replicaset, zone),
+	}
+}
+
+func NewReplicasetRemovedEvent(sts string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventTypeReplicasetRemoved,
+		Message:   fmt.Sprintf("Replicaset %s is expelled from cluster and its StatefulSet is deleted.", sts),
+	}
+}
+
+func NewReplicasetNotExpelledEvent(sts string, err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeReplicasetNotExpelled,
+		Message:   fmt.Sprintf("Replicaset %s can not be expelled yet: %s", sts, err),
+	}
+}
+
+func NewScaleDownBlockedEvent(replicasets []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeScaleDownBlocked,
+		Message: fmt.Sprintf(
+			"Storage replicasets %s are not removed, set spec.allowAutoscaling to scale down.",
+			strings.Join(replicasets, ", "),
+		),
+	}
+}
+
+func NewStorageUsageHighEvent(instances []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeStorageUsageHigh,
+		Message:   fmt.Sprintf("Storage usage exceeds thresholds on: %s.", strings.Join(instances, ", ")),
+	}
+}
+
+func NewReplicasetAddedEvent(from, to int32, instances []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventTypeReplicasetAdded,
+		Message: fmt.Sprintf(
+			"Storage policy changed spec.replicasets from %d to %d, usage exceeds thresholds on: %s.",
+			from,
+			to,
+			strings.Join(instances, ", "),
+		),
+	}
+}
+
+func NewScaleOutBlockedEvent(message string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeScaleOutBlocked,
+		Message:   fmt.Sprintf("Storage policy can not add replicaset: %s.", message),
+	}
+}
+
+func NewStorageUsageUnknownEvent(instances []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeStorageUsageUnknown,
+		Message:   fmt.Sprintf("Unable to get storage usage of: %s.", strings.Join(instances, ", ")),
+	}
+}
+
+func NewInvalidTLSSecretEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeInvalidTLSSecret,
+		Message:   fmt.Sprintf("StatefulSets are not synced until certificates are available: %s.", err),
+	}
+}
+
+func NewCertManagerNotInstalledEvent() *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeCertManagerNotInstalled,
+		Message:   "TLS issuer is set, but cert-manager CRDs are not installed, certificate is not requested.",
+	}
+}
+
+func NewUnableToConfigureReplicationEvent(pod string, err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeUnableToConfigureReplication,
+		Message:   fmt.Sprintf("Unable to configure replication of %s: %s", pod, err),
+	}
+}
+
+func NewMasterSwitchedEvent(from, to string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventTypeMasterSwitched,
+		Message:   fmt.Sprintf("Master of replicaset is switched from unavailable %s to %s.", from, to),
+	}
+}
+
+func NewInvalidElectionConfigEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeInvalidElectionConfig,
+		Message:   fmt.Sprintf("Election is not configured until spec is changed: %s.", err),
+	}
+}
+
+func NewRaftNotSupportedEvent(pod string, err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeRaftNotSupported,
+		Message:   fmt.Sprintf("Raft failover can not be used with %s: %s", pod, err),
+	}
+}
+
+func NewUnableToConfigureElectionEvent(pod string, err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeUnableToConfigureElection,
+		Message:   fmt.Sprintf("Unable to configure election of %s: %s", pod, err),
+	}
+}
+
+func NewInvalidReplicationConfigEvent(err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeInvalidReplicationConfig,
+		Message:   fmt.Sprintf("Replication params are not applied until spec is changed: %s.", err),
+	}
+}
+
+func NewUnableToSetReplicationParamsEvent(pod string, err error) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventTypeUnableToSetReplicationParams,
+		Message:   fmt.Sprintf("Unable to set replication params of %s: %s", pod, err),
+	}
+}
@@ -0,0 +1,41 @@
+package role
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ExpandVolumeClaimsStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ExpandVolumeClaimsStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Expand volume claims"
+}
+
+func (r *ExpandVolumeClaimsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	expansion, err := ctrl.GetReplicasetsManger().ExpandVolumeClaims(ctx, role)
+	if err != nil {
+		return Error(err)
+	}
+
+	// Claims stay not expandable until StorageClass is changed, so they are reported only when the set is changed
+	if role.SetVolumesNotExpandable(expansion.NotExpandable) {
+		for _, claim := range expansion.NotExpandable {
+			ctrl.GetEventsRecorder().Event(role, NewVolumeNotExpandableEvent(claim))
+		}
+	}
+
+	if len(expansion.Recreated) == 0 {
+		return NextStep()
+	}
+
+	for _, sts := range expansion.Recreated {
+		ctrl.GetEventsRecorder().Event(role, NewStatefulSetRecreatedEvent(sts))
+	}
+
+	// Deleted StatefulSets are created again with actual volume claim templates on the next reconciliation
+	return Requeue(5 * time.Second)
+}
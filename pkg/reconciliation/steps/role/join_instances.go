@@ -29,7 +29,7 @@ func (r *JoinInstancesStep[PhaseType, RoleType, CtxType, CtrlType]) Reconcile(ct
 	role := ctx.GetRole()
 	cluster := ctx.GetRelatedCluster()
 
-	topologyClient := ctrl.GetTopology()
+	membership := ctrl.GetMembership()
 	allJoined := true
 
 	var pod *v1.Pod
@@ -68,7 +68,7 @@ func (r *JoinInstancesStep[PhaseType, RoleType, CtxType, CtrlType]) Reconcile(ct
 					continue
 				}
 
-				instanceUUID, uuidErr := topologyClient.GetInstanceUUID(ctx, pod)
+				instanceUUID, uuidErr := membership.GetInstanceUUID(ctx, pod)
 				if uuidErr != nil {
 					ctx.GetLogger().Error(uuidErr, "unable to get instance uuid")
 
@@ -79,7 +79,7 @@ func (r *JoinInstancesStep[PhaseType, RoleType, CtxType, CtrlType]) Reconcile(ct
 					leader := ctx.GetLeader()
 
 					if leader.Name != pod.Name {
-						switch leaderUUID, uuidErr := topologyClient.GetInstanceUUID(ctx, leader); {
+						switch leaderUUID, uuidErr := membership.GetInstanceUUID(ctx, leader); {
 						case uuidErr != nil:
 							ctx.GetLogger().Error(uuidErr, "unable to get leader instance uuid")
 
@@ -98,7 +98,7 @@ func (r *JoinInstancesStep[PhaseType, RoleType, CtxType, CtrlType]) Reconcile(ct
 						return Error(err)
 					}
 
-					joinErr := topologyClient.Join(
+					joinErr := membership.Join(
 						ctx,
 						leader,
 						alias,
@@ -0,0 +1,44 @@
+package role
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// LabelMastersStep marks pods of active masters of replicasets of role known by cartridge,
+// -rw and -ro client Services select pods by this label.
+type LabelMastersStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *LabelMastersStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Label masters"
+}
+
+func (r *LabelMastersStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	replicasetUUIDs := map[string]bool{}
+	for i := int32(0); i < role.GetReplicasets(); i++ {
+		replicasetUUIDs[ctrl.GetReplicasetsManger().GetReplicasetUUID(role, i)] = true
+	}
+
+	replicasets, err := ctrl.GetMembership().GetReplicasets(ctx, ctx.GetLeader())
+	if err != nil {
+		return Error(err)
+	}
+
+	masters := map[string]bool{}
+
+	for _, replicaset := range replicasets {
+		if replicasetUUIDs[replicaset.UUID] && replicaset.MasterURI != "" {
+			masters[utils.PodNameFromURI(replicaset.MasterURI)] = true
+		}
+	}
+
+	err = ctrl.GetReplicasetsManger().SetMasterLabels(ctx, role, masters)
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,21 @@
+package role
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ReportScaleStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ReportScaleStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Report scale"
+}
+
+func (r *ReportScaleStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	err := ctrl.GetReplicasetsManger().ReportScale(ctx, ctx.GetRole())
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,39 @@
+package role
+
+import (
+	"sort"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ReportZonesStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ReportZonesStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Report zones"
+}
+
+func (r *ReportZonesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	singleZone, err := ctrl.GetReplicasetsManger().ReportZones(ctx, role)
+	if err != nil {
+		return Error(err)
+	}
+
+	replicasets := make([]string, 0, len(singleZone))
+	for replicaset := range singleZone {
+		replicasets = append(replicasets, replicaset)
+	}
+
+	sort.Strings(replicasets)
+
+	// Placement of pods rarely changes, so replicasets are reported only when the condition is changed
+	if role.SetSingleZoneReplicasets(replicasets) {
+		for _, replicaset := range replicasets {
+			ctrl.GetEventsRecorder().Event(role, NewReplicasInSingleZoneEvent(replicaset, singleZone[replicaset]))
+		}
+	}
+
+	return NextStep()
+}
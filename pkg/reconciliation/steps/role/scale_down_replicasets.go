@@ -0,0 +1,129 @@
+package role
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/apps/v1"
+)
+
+// ScaleDownReplicasetsStep expels replicasets which ordinals are beyond number of replicasets of role
+// and removes their StatefulSets. Buckets of storage replicasets are moved away by zero weight,
+// replicaset is expelled only when it stores no buckets. Storage replicasets are kept
+// until autoscaling of role is allowed, so data is never removed by scale subresource.
+type ScaleDownReplicasetsStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ScaleDownReplicasetsStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Scale down replicasets"
+}
+
+func (r *ScaleDownReplicasetsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	removed, err := ctrl.GetReplicasetsManger().GetRemovedReplicasets(ctx, role)
+	if err != nil {
+		return Error(err)
+	}
+
+	if !role.IsAutoscalingAllowed() {
+		blocked := make([]string, 0, len(removed))
+		for key := range removed {
+			blocked = append(blocked, removed[key].GetName())
+		}
+
+		if role.SetScaleDownBlocked(blocked) && len(blocked) > 0 {
+			ctrl.GetEventsRecorder().Event(role, NewScaleDownBlockedEvent(blocked))
+		}
+
+		return NextStep()
+	}
+
+	role.SetScaleDownBlocked(nil)
+
+	pending := false
+
+	for key := range removed {
+		sts := &removed[key]
+		replicasetUUID := sts.GetLabels()[ctrl.GetLabelsManager().ReplicasetUUID()]
+
+		if role.IsStorage() {
+			rebalanced, err := r.moveBuckets(ctx, ctrl, sts, replicasetUUID)
+			if err != nil {
+				ctrl.GetEventsRecorder().Event(role, NewReplicasetNotExpelledEvent(sts.GetName(), err))
+			}
+
+			if !rebalanced {
+				pending = true
+
+				continue
+			}
+		}
+
+		err = ctrl.GetMembership().ExpelReplicaset(ctx, ctx.GetLeader(), replicasetUUID)
+		if err != nil {
+			ctrl.GetEventsRecorder().Event(role, NewReplicasetNotExpelledEvent(sts.GetName(), err))
+
+			pending = true
+
+			continue
+		}
+
+		err = ctrl.GetReplicasetsManger().RemoveReplicaset(ctx, role, sts)
+		if err != nil {
+			return Error(err)
+		}
+
+		ctrl.GetEventsRecorder().Event(role, NewReplicasetRemovedEvent(sts.GetName()))
+	}
+
+	if pending {
+		return Requeue(10 * time.Second)
+	}
+
+	return NextStep()
+}
+
+// moveBuckets sets zero weight of replicaset and returns true when all its buckets are moved away.
+// Rebalancing in progress is not an error, so it is not reported.
+func (r *ScaleDownReplicasetsStep[RoleType, CtxType, CtrlType]) moveBuckets(
+	ctx CtxType,
+	ctrl CtrlType,
+	sts *v1.StatefulSet,
+	replicasetUUID string,
+) (bool, error) {
+	role := ctx.GetRole()
+
+	err := ctrl.GetSharding().SetWeight(ctx, ctx.GetLeader(), replicasetUUID, 0)
+	if err != nil && !errors.Is(err, topology.ErrNotInConfig) {
+		return false, err
+	}
+
+	podList, err := ctrl.GetResourcesManager().ListPods(
+		ctx,
+		role.GetNamespace(),
+		ctrl.GetLabelsManager().SelectorByReplicasetName(role, sts.GetName()),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	for key := range podList.Items {
+		pod := &podList.Items[key]
+		if utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+			continue
+		}
+
+		count, err := ctrl.GetSharding().GetBucketsCount(ctx, pod)
+		if err != nil {
+			return false, err
+		}
+
+		return count == 0, nil
+	}
+
+	return false, errors.New("no running instances to check buckets count")
+}
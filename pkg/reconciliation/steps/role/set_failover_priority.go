@@ -0,0 +1,77 @@
+package role
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+// SetFailoverPriorityStep moves instances placed in primary zone of role to the head of failover priority.
+type SetFailoverPriorityStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *SetFailoverPriorityStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Set failover priority"
+}
+
+func (r *SetFailoverPriorityStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	zone := role.GetPrimaryZone()
+	if zone == "" {
+		return NextStep()
+	}
+
+	replicasetUUIDs := map[string]bool{}
+	for i := int32(0); i < role.GetReplicasets(); i++ {
+		replicasetUUIDs[ctrl.GetReplicasetsManger().GetReplicasetUUID(role, i)] = true
+	}
+
+	replicasets, err := ctrl.GetMembership().GetReplicasets(ctx, ctx.GetLeader())
+	if err != nil {
+		return Error(err)
+	}
+
+	for k := range replicasets {
+		replicaset := &replicasets[k]
+		if !replicasetUUIDs[replicaset.UUID] {
+			continue
+		}
+
+		actual, desired := r.failoverPriority(role, replicaset, zone)
+		if cmp.Equal(actual, desired) {
+			continue
+		}
+
+		err = ctrl.GetFailover().SetFailoverPriority(ctx, ctx.GetLeader(), replicaset.UUID, desired)
+		if err != nil {
+			return Error(err)
+		}
+	}
+
+	return NextStep()
+}
+
+// failoverPriority returns actual order of instances and the same order with instances of zone moved to the head.
+func (r *SetFailoverPriorityStep[RoleType, CtxType, CtrlType]) failoverPriority(
+	role RoleType,
+	replicaset *topology.ReplicasetInfo,
+	zone string,
+) ([]string, []string) {
+	actual := make([]string, 0, len(replicaset.Servers))
+	preferred := make([]string, 0, len(replicaset.Servers))
+	others := make([]string, 0, len(replicaset.Servers))
+
+	for _, server := range replicaset.Servers {
+		actual = append(actual, server.UUID)
+
+		if role.GetInstanceZone(utils.PodNameFromURI(server.URI)) == zone {
+			preferred = append(preferred, server.UUID)
+		} else {
+			others = append(others, server.UUID)
+		}
+	}
+
+	return actual, append(preferred, others...)
+}
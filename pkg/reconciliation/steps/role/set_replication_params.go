@@ -0,0 +1,84 @@
+package role
+
+import (
+	"time"
+
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// SetReplicationParamsStep applies replication options of role with box.cfg on every running instance.
+// Synchro quorum formula is evaluated with number of replicas of role, so quorum follows scaling of replicasets.
+type SetReplicationParamsStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *SetReplicationParamsStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Set replication params"
+}
+
+func (r *SetReplicationParamsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	replication := role.GetReplicationConfig()
+	if replication == nil {
+		return NextStep()
+	}
+
+	quorum, _, err := synchroQuorum(role)
+	if err != nil {
+		ctrl.GetEventsRecorder().Event(role, NewInvalidReplicationConfigEvent(err))
+
+		return Complete()
+	}
+
+	params := &topology.ReplicationParams{
+		SynchroQuorum:  quorum,
+		SynchroTimeout: replication.GetSynchroTimeout().Seconds(),
+		Timeout:        replication.GetTimeout().Seconds(),
+		ConnectQuorum:  replication.GetConnectQuorum(),
+	}
+
+	podList, err := ctrl.GetResourcesManager().ListPods(ctx, role.GetNamespace(), ctrl.GetLabelsManager().SelectorByRoleName(role))
+	if err != nil {
+		return Error(err)
+	}
+
+	for key := range podList.Items {
+		pod := &podList.Items[key]
+		if utils.IsPodDeleting(pod) || !utils.IsPodRunning(pod) {
+			continue
+		}
+
+		err = ctrl.GetReplicationOptions().SetReplicationParams(ctx, pod, params)
+		if err != nil {
+			ctrl.GetEventsRecorder().Event(role, NewUnableToSetReplicationParamsEvent(pod.GetName(), err))
+
+			return Requeue(10 * time.Second)
+		}
+	}
+
+	return NextStep()
+}
+
+// synchroQuorum returns synchro quorum of replicasets of role, formula is evaluated with number of replicas.
+// It returns false if quorum is not set in role spec.
+func synchroQuorum(role api.Role) (int32, bool, error) {
+	replication := role.GetReplicationConfig()
+	if replication == nil || replication.GetSynchroQuorum() == nil {
+		return 0, false, nil
+	}
+
+	quorum := replication.GetSynchroQuorum()
+	if quorum.Type == intstr.Int {
+		return quorum.IntVal, true, nil
+	}
+
+	value, err := utils.EvalQuorum(quorum.StrVal, role.GetReplicas())
+	if err != nil {
+		return 0, false, err
+	}
+
+	return value, true, nil
+}
@@ -14,7 +14,7 @@ func (r *SetVShardWeightsStep[RoleType, CtxType, CtrlType]) GetName() string {
 func (r *SetVShardWeightsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
 	role := ctx.GetRole()
 	config := role.GetVShardConfig()
-	topologyClient := ctrl.GetTopology()
+	sharding := ctrl.GetSharding()
 	weight := config.GetWeight()
 
 	for i := int32(0); i < role.GetReplicasets(); i++ {
@@ -35,7 +35,7 @@ func (r *SetVShardWeightsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxTyp
 			stsLabels := sts.GetLabels()
 			stsUUID := stsLabels[ctrl.GetLabelsManager().ReplicasetUUID()]
 
-			err = topologyClient.SetWeight(ctx, ctx.GetLeader(), stsUUID, weight)
+			err = sharding.SetWeight(ctx, ctx.GetLeader(), stsUUID, weight)
 			if err != nil {
 				return Error(err)
 			}
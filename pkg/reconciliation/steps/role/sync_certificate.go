@@ -0,0 +1,127 @@
+package role
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SyncCertificateStep creates cert-manager Certificate of cluster before StatefulSets of role mount its Secret.
+// Single certificate is valid for FQDNs of all instances, because pods of StatefulSet can not mount different Secrets,
+// so every role of cluster syncs the same Certificate owned by cluster.
+// Certificate is deleted when issuer is removed from cluster spec.
+type SyncCertificateStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *SyncCertificateStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Sync certificate"
+}
+
+func (r *SyncCertificateStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	cluster := ctx.GetRelatedCluster()
+	tls := cluster.GetTLSConfig()
+
+	certificate := utils.NewCertificate()
+
+	err := ctrl.Get(ctx, types.NamespacedName{Namespace: cluster.GetNamespace(), Name: cluster.GetName()}, certificate)
+	exists := err == nil
+
+	switch {
+	case meta.IsNoMatchError(err):
+		if tls != nil && tls.GetIssuerName() != "" {
+			ctrl.GetEventsRecorder().Event(ctx.GetRole(), NewCertManagerNotInstalledEvent())
+		}
+
+		return NextStep()
+	case err != nil && !apierrors.IsNotFound(err):
+		return Error(err)
+	}
+
+	if tls == nil || tls.GetIssuerName() == "" {
+		if !exists || !metav1.IsControlledBy(certificate, cluster) {
+			return NextStep()
+		}
+
+		err = ctrl.Delete(ctx, certificate)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return Error(err)
+		}
+
+		return NextStep()
+	}
+
+	if !exists {
+		certificate = utils.NewCertificate()
+		certificate.SetName(cluster.GetName())
+		certificate.SetNamespace(cluster.GetNamespace())
+	}
+
+	changed, err := ctrl.GetResourcesManager().ControlObject(cluster, certificate)
+	if err != nil {
+		return Error(err)
+	}
+
+	labels := map[string]string{
+		ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+	}
+	if !cmp.Equal(certificate.GetLabels(), labels) {
+		certificate.SetLabels(labels)
+		changed = true
+	}
+
+	// Instances are addressed as <pod>.<cluster>.<namespace>.svc.<domain> by headless Service of cluster.
+	// Common name is not set, it is limited to 64 characters and is ignored by clients in favor of DNS names.
+	serviceDomain := fmt.Sprintf("%s.%s.svc.%s", cluster.GetName(), cluster.GetNamespace(), cluster.GetDomain())
+
+	spec := map[string]interface{}{
+		"secretName": tls.GetSecretName(),
+		// Label of issued Secret lets roles watch only Secrets of clusters
+		"secretTemplate": map[string]interface{}{
+			"labels": map[string]interface{}{
+				ctrl.GetLabelsManager().ClusterName(): cluster.GetName(),
+			},
+		},
+		"dnsNames": []interface{}{
+			serviceDomain,
+			"*." + serviceDomain,
+		},
+		"usages": []interface{}{
+			"server auth",
+			"client auth",
+		},
+		"issuerRef": map[string]interface{}{
+			"name":  tls.GetIssuerName(),
+			"kind":  tls.GetIssuerKind(),
+			"group": tls.GetIssuerGroup(),
+		},
+	}
+
+	actual, _, _ := unstructured.NestedMap(certificate.Object, "spec")
+	if !cmp.Equal(actual, spec) {
+		err = unstructured.SetNestedMap(certificate.Object, spec, "spec")
+		if err != nil {
+			return Error(err)
+		}
+
+		changed = true
+	}
+
+	if !exists {
+		err = ctrl.GetResourcesManager().CreateObject(ctx, certificate)
+	} else if changed {
+		err = ctrl.GetResourcesManager().UpdateObject(ctx, certificate)
+	}
+
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
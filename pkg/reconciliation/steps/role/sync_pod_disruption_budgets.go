@@ -0,0 +1,27 @@
+package role
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SyncPodDisruptionBudgetsStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *SyncPodDisruptionBudgetsStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Sync PodDisruptionBudgets"
+}
+
+func (r *SyncPodDisruptionBudgetsStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	role := ctx.GetRole()
+
+	if role.GetDeletionTimestamp() != nil {
+		return NextStep()
+	}
+
+	err := ctrl.GetReplicasetsManger().SyncPodDisruptionBudgets(ctx, ctx.GetRelatedCluster(), role)
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
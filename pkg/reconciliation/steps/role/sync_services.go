@@ -0,0 +1,21 @@
+package role
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SyncServicesStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *SyncServicesStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Sync client services"
+}
+
+func (r *SyncServicesStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	err := ctrl.GetReplicasetsManger().SyncServices(ctx, ctx.GetRelatedCluster(), ctx.GetRole())
+	if err != nil {
+		return Error(err)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,32 @@
+package role
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ValidateTLSSecretStep[RoleType api.Role, CtxType RoleContext[RoleType], CtrlType RoleController[RoleType]] struct{}
+
+func (r *ValidateTLSSecretStep[RoleType, CtxType, CtrlType]) GetName() string {
+	return "Validate TLS secret"
+}
+
+// Reconcile prevents creation of StatefulSets which pods can not start without certificates.
+func (r *ValidateTLSSecretStep[RoleType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	err := ctrl.GetReplicasetsManger().ValidateTLSSecret(ctx, ctx.GetRelatedCluster())
+	if err != nil {
+		if errors.Is(err, k8s.ErrInvalidTLSSecret) {
+			ctrl.GetEventsRecorder().Event(ctx.GetRole(), NewInvalidTLSSecretEvent(err))
+
+			return Requeue(10 * time.Second)
+		}
+
+		return Error(err)
+	}
+
+	return NextStep()
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+const destructiveChangeClassName = "DestructiveChange"
+
+type ApplyStep[PhaseType comparable, SchemaType api.CartridgeSchemaWithStatus[PhaseType], CtxType CartridgeSchemaContext[SchemaType], CtrlType CartridgeSchemaController] struct {
+	// InvalidPhase is set when schema is rejected by cartridge_check_schema
+	InvalidPhase PhaseType
+	// DestructivePhase is set when schema contains destructive changes which are not allowed
+	DestructivePhase PhaseType
+}
+
+func (r *ApplyStep[PhaseType, SchemaType, CtxType, CtrlType]) GetName() string {
+	return "Apply cartridge schema"
+}
+
+func (r *ApplyStep[PhaseType, SchemaType, CtxType, CtrlType]) Reconcile(ctx CtxType, ctrl CtrlType) (*Result, error) {
+	schema := ctx.GetSchema()
+	desiredSchema := schema.GetSchema()
+
+	version, err := utils.HashObject(desiredSchema)
+	if err != nil {
+		return Error(err)
+	}
+
+	actualSchema, err := ctrl.GetClusterwideConfig().GetSchema(ctx, ctx.GetLeader())
+	if err != nil {
+		return Error(err)
+	}
+
+	if isSameSchema(actualSchema, desiredSchema) {
+		ctx.GetLogger().Info("Nothing to change in schema")
+		schema.SetAppliedVersion(version)
+
+		return NextStep()
+	}
+
+	err = ctrl.GetClusterwideConfig().CheckSchema(ctx, ctx.GetLeader(), desiredSchema)
+	if err != nil {
+		var validationErr *topology.SchemaValidationError
+		if errors.As(err, &validationErr) {
+			schema.SetPhase(r.InvalidPhase)
+			schema.SetError(validationErr.ClassName, validationErr.Err)
+			ctrl.GetEventsRecorder().Event(schema, NewInvalidSchemaEvent(validationErr.LuaError))
+
+			// Schema stays invalid until CartridgeSchema is changed.
+			return Complete()
+		}
+
+		return Error(err)
+	}
+
+	changes, err := utils.DestructiveSchemaChanges(actualSchema, desiredSchema)
+	if err != nil {
+		return Error(err)
+	}
+
+	if len(changes) > 0 && !schema.IsDestructiveAllowed() {
+		schema.SetPhase(r.DestructivePhase)
+		schema.SetError(destructiveChangeClassName, strings.Join(changes, "; "))
+		ctrl.GetEventsRecorder().Event(schema, NewDestructiveSchemaChangeEvent(changes))
+
+		return Complete()
+	}
+
+	err = ctrl.GetClusterwideConfig().ApplySchema(ctx, ctx.GetLeader(), desiredSchema)
+	if err != nil {
+		ctx.GetLogger().Error(err, "Unable to apply cartridge schema")
+
+		var luaErr *topology.LuaError
+		if errors.As(err, &luaErr) {
+			schema.SetError(luaErr.ClassName, luaErr.Err)
+			ctrl.GetEventsRecorder().Event(schema, NewUnableToApplySchemaEvent(luaErr))
+		}
+
+		return Error(err)
+	}
+
+	schema.SetAppliedVersion(version)
+	ctrl.GetEventsRecorder().Event(schema, NewSchemaAppliedEvent(version))
+
+	return NextStep()
+}
+
+func isSameSchema(actual, desired string) bool {
+	var actualData, desiredData any
+
+	if yaml.Unmarshal([]byte(actual), &actualData) != nil {
+		return false
+	}
+
+	if yaml.Unmarshal([]byte(desired), &desiredData) != nil {
+		return false
+	}
+
+	return cmp.Equal(actualData, desiredData)
+}
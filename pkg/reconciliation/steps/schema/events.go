@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tarantool/tarantool-operator/pkg/events"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	EventInvalidSchema           = "InvalidSchema"
+	EventDestructiveSchemaChange = "DestructiveSchemaChange"
+	EventUnableToApplySchema     = "UnableToApplySchema"
+	EventSchemaApplied           = "SchemaApplied"
+)
+
+func NewInvalidSchemaEvent(err *topology.LuaError) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventInvalidSchema,
+		Message:   fmt.Sprintf("Schema rejected: %s", err.Error()),
+	}
+}
+
+func NewDestructiveSchemaChangeEvent(changes []string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventDestructiveSchemaChange,
+		Message:   fmt.Sprintf("Schema is not applied, set allowDestructive to apply destructive changes: %s", strings.Join(changes, "; ")),
+	}
+}
+
+func NewUnableToApplySchemaEvent(err *topology.LuaError) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeWarning,
+		Reason:    EventUnableToApplySchema,
+		Message:   err.Error(),
+	}
+}
+
+func NewSchemaAppliedEvent(version string) *events.Event {
+	return &events.Event{
+		EventType: corev1.EventTypeNormal,
+		Reason:    EventSchemaApplied,
+		Message:   fmt.Sprintf("Schema version %s applied successfully.", version),
+	}
+}
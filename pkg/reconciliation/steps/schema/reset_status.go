@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type ResetStatusStep[SchemaType api.CartridgeSchema, CtxType CartridgeSchemaContext[SchemaType], CtrlType CartridgeSchemaController] struct{}
+
+func (r *ResetStatusStep[SchemaType, CtxType, CtrlType]) GetName() string {
+	return "Reset cartridge schema status"
+}
+
+func (r *ResetStatusStep[SchemaType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	ctx.GetSchema().ResetStatus()
+
+	return NextStep()
+}
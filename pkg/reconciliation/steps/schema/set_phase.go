@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	. "github.com/tarantool/tarantool-operator/pkg/reconciliation"
+)
+
+type SetPhaseStep[PhaseType comparable, SchemaType api.CartridgeSchemaWithStatus[PhaseType], CtxType CartridgeSchemaContext[SchemaType], CtrlType CartridgeSchemaController] struct {
+	Phase PhaseType
+}
+
+func (r *SetPhaseStep[PhaseType, SchemaType, CtxType, CtrlType]) GetName() string {
+	return "Set cartridge schema phase"
+}
+
+func (r *SetPhaseStep[PhaseType, SchemaType, CtxType, CtrlType]) Reconcile(ctx CtxType, _ CtrlType) (*Result, error) {
+	ctx.GetSchema().SetPhase(r.Phase)
+
+	return NextStep()
+}
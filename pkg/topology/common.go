@@ -11,7 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
-// CommonCartridgeTopology .
+// CommonCartridgeTopology provides all capabilities of CartridgeTopology with lua API of Cartridge.
 type CommonCartridgeTopology struct {
 	Transport transport.Transport
 }
@@ -165,6 +165,67 @@ func (r *CommonCartridgeTopology) SetReplicasetRoles(ctx context.Context, leader
 	return nil
 }
 
+// SetFailoverPriority sets order in which instances of replicaset become master.
+func (r *CommonCartridgeTopology) SetFailoverPriority(ctx context.Context, leader *v1.Pod, replicasetUUID string, instanceUUIDs []string) error {
+	editTopology := EditTopologyParams{
+		Replicasets: []EditReplicasetParams{
+			{
+				UUID:             replicasetUUID,
+				FailoverPriority: instanceUUIDs,
+			},
+		},
+	}
+
+	success, err := r.adminEditTopology(ctx, leader, editTopology)
+	if err != nil {
+		return err
+	}
+
+	if !success {
+		return ErrTopologyIsDown
+	}
+
+	return nil
+}
+
+// ExpelReplicaset expels all servers of replicaset, it does nothing if replicaset is not in config.
+func (r *CommonCartridgeTopology) ExpelReplicaset(ctx context.Context, leader *v1.Pod, replicasetUUID string) error {
+	replicasets, err := r.GetReplicasets(ctx, leader)
+	if err != nil {
+		return err
+	}
+
+	editTopology := EditTopologyParams{}
+
+	for _, replicaset := range replicasets {
+		if replicaset.UUID != replicasetUUID {
+			continue
+		}
+
+		for _, server := range replicaset.Servers {
+			editTopology.Servers = append(editTopology.Servers, EditServerParams{
+				UUID:     server.UUID,
+				Expelled: true,
+			})
+		}
+	}
+
+	if len(editTopology.Servers) == 0 {
+		return nil
+	}
+
+	success, err := r.adminEditTopology(ctx, leader, editTopology)
+	if err != nil {
+		return err
+	}
+
+	if !success {
+		return ErrTopologyIsDown
+	}
+
+	return nil
+}
+
 // GetReplicasetRoles get roles list of replicaset from the Tarantool service.
 func (r *CommonCartridgeTopology) GetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string) ([]string, error) {
 	var res []string
@@ -218,6 +279,31 @@ func (r *CommonCartridgeTopology) BootstrapVshard(ctx context.Context, leader *v
 	return nil
 }
 
+// GetBucketsCount retrieves number of buckets stored by replicaset of instance, it is 0 before vshard is bootstrapped.
+func (r *CommonCartridgeTopology) GetBucketsCount(ctx context.Context, pod *v1.Pod) (int64, error) {
+	// language=lua
+	lua := `
+		if type(box.cfg) == 'function' or box.space._bucket == nil then
+			return { res = 0, err = nil }
+		end
+
+		return { res = box.space._bucket:count(), err = nil }
+	`
+
+	var res *Int64Result
+
+	err := r.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to retrieve buckets count")
+	}
+
+	if res.Err != nil {
+		return 0, errors.Wrap(res.Err, "unable to retrieve buckets count")
+	}
+
+	return res.Res, nil
+}
+
 func (r *CommonCartridgeTopology) GetRolesHierarchy(ctx context.Context, leader *v1.Pod) (map[string][]string, error) {
 	// language=lua
 	lua := `
@@ -306,7 +392,7 @@ func (r *CommonCartridgeTopology) GetFailoverParams(ctx context.Context, leader
 	return &res, err
 }
 
-func (r *CommonCartridgeTopology) GetCartridgeConfig(ctx context.Context, leader *v1.Pod) (CartridgeConfigData, error) {
+func (r *CommonCartridgeTopology) GetConfig(ctx context.Context, leader *v1.Pod) (ClusterwideConfigData, error) {
 	// language=lua
 	lua := `
 		local cartridge = require('cartridge')
@@ -342,7 +428,7 @@ func (r *CommonCartridgeTopology) GetCartridgeConfig(ctx context.Context, leader
 	err := r.Exec(ctx, leader, &res, lua)
 
 	if errors.As(err, &jsonErr) {
-		return CartridgeConfigData{}, nil
+		return ClusterwideConfigData{}, nil
 	}
 
 	if err != nil {
@@ -352,7 +438,129 @@ func (r *CommonCartridgeTopology) GetCartridgeConfig(ctx context.Context, leader
 	return res, nil
 }
 
-func (r *CommonCartridgeTopology) ApplyCartridgeConfig(ctx context.Context, leader *v1.Pod, config CartridgeConfigData) error {
+// ValidateConfig checks config with validate_config hooks of the application on all instances of cluster
+// without applying it. It runs prepare phase of Cartridge two-phase commit on every enabled instance and aborts it.
+// It returns ConfigValidationError when config is rejected by application
+// and ErrConfigNotActive when cluster-wide config is not applied on leader yet.
+func (r *CommonCartridgeTopology) ValidateConfig(ctx context.Context, leader *v1.Pod, config ClusterwideConfigData) error {
+	// language=lua
+	lua := `
+	local yaml = require('yaml')
+	local fun = require('fun')
+	local pool = require('cartridge.pool')
+	local topology = require('cartridge.topology')
+	local confapplier = require('cartridge.confapplier')
+	local blacklist = {
+		['auth'] = true,
+		['auth.yml'] = true,
+		['topology'] = true,
+		['topology.yml'] = true,
+		['users_acl'] = true,
+		['users_acl.yml'] = true,
+		['vshard'] = true,
+		['vshard.yml'] = true,
+		['vshard_groups'] = true,
+		['vshard_groups.yml'] = true,
+		['schema.yml'] = true,
+	}
+
+	local desiredConfig = ...
+	local activeConfig = confapplier.get_active_config()
+	if activeConfig == nil then
+		-- Nothing to validate against until cluster-wide config is applied
+		return { res = false, err = nil }
+	end
+
+	local newConfig = activeConfig:copy()
+	for section, data in pairs(desiredConfig) do
+		if not blacklist[section] then
+			newConfig:set_plaintext(section, nil)
+			newConfig:set_plaintext(section .. '.yml', nil)
+
+			if type(data) == 'string' then
+				newConfig:set_plaintext(section, data)
+			else
+				newConfig:set_plaintext(section .. '.yml', yaml.encode(data))
+			end
+		end
+	end
+	newConfig:lock()
+
+	local uriList = {}
+	for _, _, server in fun.filter(topology.not_disabled, newConfig:get_readonly('topology').servers) do
+		table.insert(uriList, server.uri)
+	end
+
+	-- Failures which are not caused by config itself are reported as ValidationIncomplete to be retried
+	local function incomplete(err)
+		return { res = nil, err = { class_name = 'ValidationIncomplete', err = type(err) == 'table' and err.err or tostring(err) } }
+	end
+
+	-- Since Cartridge 2.4 config is uploaded to instances before prepare phase and is referenced by upload id
+	local prepareArg = newConfig:get_plaintext()
+	local ok, upload = pcall(require, 'cartridge.upload')
+	if ok then
+		local uploadId, err = upload.upload(prepareArg, { uri_list = uriList })
+		if uploadId == nil then
+			return incomplete(err)
+		end
+		prepareArg = uploadId
+	end
+
+	local prepared, errs = pool.map_call('_G.__cartridge_clusterwide_config_prepare_2pc', { prepareArg }, {
+		uri_list = uriList,
+	})
+
+	-- Config is never committed, prepared instances are unlocked for next two-phase commits
+	local preparedList = {}
+	for uri in pairs(prepared or {}) do
+		table.insert(preparedList, uri)
+	end
+
+	local _, abortErrs = pool.map_call('_G.__cartridge_clusterwide_config_abort_2pc', { prepareArg }, {
+		uri_list = preparedList,
+	})
+	if abortErrs ~= nil then
+		return incomplete(abortErrs)
+	end
+
+	if errs ~= nil then
+		-- Error of the first instance which rejected config is reported, unreachable instances are retried
+		local err = errs.suberrors and errs.suberrors[1] or errs
+		local class = type(err) == 'table' and tostring(err.class_name) or ''
+		if class:startswith('Netbox') or class:startswith('Net.box') then
+			return incomplete(err)
+		end
+
+		return { res = nil, err = err }
+	end
+
+	return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Exec(ctx, leader, &res, lua, config)
+	if err != nil {
+		return errors.Wrap(err, "failed to validate cartridge config")
+	}
+
+	if res.Err != nil {
+		if res.Err.ClassName == "ValidationIncomplete" {
+			return errors.Wrap(res.Err, "failed to validate cartridge config")
+		}
+
+		return NewConfigValidationError(res.Err)
+	}
+
+	if !res.Res {
+		return ErrConfigNotActive
+	}
+
+	return nil
+}
+
+func (r *CommonCartridgeTopology) ApplyConfig(ctx context.Context, leader *v1.Pod, config ClusterwideConfigData) error {
 	// language=lua
 	lua := `
 	local cartridge = require('cartridge')
@@ -378,24 +586,375 @@ func (r *CommonCartridgeTopology) ApplyCartridgeConfig(ctx context.Context, lead
 		end
 	end
 
-	return cartridge.config_patch_clusterwide(safeConfig)
+	local res, err = cartridge.config_patch_clusterwide(safeConfig)
+	return { res = res == true, err = err }
 	`
 
-	var res bool
+	var res *BooleanResult
 
 	err := r.Exec(ctx, leader, &res, lua, config)
 	if err != nil {
 		return errors.Wrap(err, "failed to upload cartridge config")
 	}
 
-	if !res {
+	if res.Err != nil {
+		return errors.Wrap(res.Err, "failed to upload cartridge config")
+	}
+
+	if !res.Res {
 		return fmt.Errorf("failed to upload cartridge config")
 	}
 
 	return nil
 }
 
-func (r *CommonCartridgeTopology) IsCartridgeStarted(ctx context.Context, pod *v1.Pod) (bool, error) {
+// GetSchema retrieves DDL schema of the cluster in yaml.
+func (r *CommonCartridgeTopology) GetSchema(ctx context.Context, leader *v1.Pod) (string, error) {
+	// language=lua
+	lua := `
+		local schema, err = _G.cartridge_get_schema()
+		return { res = schema, err = err }
+	`
+
+	var res *StringResult
+
+	err := r.Exec(ctx, leader, &res, lua)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to download schema")
+	}
+
+	if res.Err != nil {
+		return "", errors.Wrap(res.Err, "failed to download schema")
+	}
+
+	return res.Res, nil
+}
+
+// CheckSchema validates DDL schema without applying it.
+// It returns SchemaValidationError when schema is rejected.
+func (r *CommonCartridgeTopology) CheckSchema(ctx context.Context, leader *v1.Pod, schema string) error {
+	// language=lua
+	lua := `
+		local ok, err = _G.cartridge_check_schema(...)
+		return { res = ok == true, err = err }
+	`
+
+	var res *BooleanResult
+
+	err := r.Exec(ctx, leader, &res, lua, schema)
+	if err != nil {
+		return errors.Wrap(err, "failed to check schema")
+	}
+
+	if res.Err != nil {
+		return NewSchemaValidationError(res.Err)
+	}
+
+	if !res.Res {
+		return fmt.Errorf("failed to check schema")
+	}
+
+	return nil
+}
+
+// ApplySchema applies DDL schema on the whole cluster.
+func (r *CommonCartridgeTopology) ApplySchema(ctx context.Context, leader *v1.Pod, schema string) error {
+	// language=lua
+	lua := `
+		local schema, err = _G.cartridge_set_schema(...)
+		return { res = schema ~= nil, err = err }
+	`
+
+	var res *BooleanResult
+
+	err := r.Exec(ctx, leader, &res, lua, schema)
+	if err != nil {
+		return errors.Wrap(err, "failed to upload schema")
+	}
+
+	if res.Err != nil {
+		return errors.Wrap(res.Err, "failed to upload schema")
+	}
+
+	if !res.Res {
+		return fmt.Errorf("failed to upload schema")
+	}
+
+	return nil
+}
+
+// GetReplicasets retrieves all replicasets of the cluster with their servers.
+func (r *CommonCartridgeTopology) GetReplicasets(ctx context.Context, leader *v1.Pod) ([]ReplicasetInfo, error) {
+	// language=lua
+	lua := `
+		local cartridge = require('cartridge')
+		local replicasets, err = cartridge.admin_get_replicasets()
+		if replicasets == nil then
+			return { res = nil, err = err }
+		end
+
+		local res = setmetatable({}, { __serialize = 'seq' })
+		for _, replicaset in ipairs(replicasets) do
+			-- active master differs from configured one after failover
+			local master = replicaset.active_master or replicaset.master
+			local servers = setmetatable({}, { __serialize = 'seq' })
+			for _, server in ipairs(replicaset.servers) do
+				table.insert(servers, { uuid = server.uuid, uri = server.uri, alias = server.alias })
+			end
+
+			table.insert(res, {
+				uuid = replicaset.uuid,
+				alias = replicaset.alias,
+				roles = setmetatable(replicaset.roles or {}, { __serialize = 'seq' }),
+				weight = replicaset.weight,
+				all_rw = replicaset.all_rw,
+				vshard_group = replicaset.vshard_group,
+				master_uri = master and master.uri,
+				servers = servers,
+			})
+		end
+
+		return { res = res, err = nil }
+	`
+
+	var res *LuaCallResult[[]ReplicasetInfo]
+
+	err := r.Exec(ctx, leader, &res, lua)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve replicasets")
+	}
+
+	if res.Err != nil {
+		return nil, errors.Wrap(res.Err, "failed to retrieve replicasets")
+	}
+
+	return res.Res, nil
+}
+
+// GetTopologyState retrieves active leaders of replicasets and membership statuses of instances.
+func (r *CommonCartridgeTopology) GetTopologyState(ctx context.Context, leader *v1.Pod) (*TopologyState, error) {
+	// language=lua
+	lua := `
+		local failover = require('cartridge.failover')
+		local membership = require('membership')
+
+		local active_leaders = setmetatable({}, { __serialize = 'map' })
+		for replicaset_uuid, instance_uuid in pairs(failover.get_active_leaders() or {}) do
+			active_leaders[replicaset_uuid] = instance_uuid
+		end
+
+		local members = setmetatable({}, { __serialize = 'map' })
+		for uri, member in pairs(membership.members()) do
+			members[uri] = member.status
+		end
+
+		return {
+			res = { active_leaders = active_leaders, members = members },
+			err = nil,
+		}
+	`
+
+	var res *LuaCallResult[*TopologyState]
+
+	err := r.Exec(ctx, leader, &res, lua)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve topology state")
+	}
+
+	if res.Err != nil {
+		return nil, errors.Wrap(res.Err, "failed to retrieve topology state")
+	}
+
+	return res.Res, nil
+}
+
+// StartBackup makes a snapshot on the instance and pins snapshot and xlog files until StopBackup is called.
+func (r *CommonCartridgeTopology) StartBackup(ctx context.Context, pod *v1.Pod) (*BackupFiles, error) {
+	// language=lua
+	lua := `
+		local fio = require('fio')
+
+		-- Previous attempt could be interrupted before box.backup.stop
+		pcall(box.backup.stop)
+
+		local ok, err = pcall(box.snapshot)
+		if not ok then
+			return { res = nil, err = { class_name = 'SnapshotError', err = tostring(err) } }
+		end
+
+		local files = box.backup.start()
+
+		return {
+			res = {
+				workdir = fio.abspath(box.cfg.work_dir or fio.cwd()),
+				files = setmetatable(files, { __serialize = 'seq' }),
+			},
+			err = nil,
+		}
+	`
+
+	var res *LuaCallResult[*BackupFiles]
+
+	err := r.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start backup")
+	}
+
+	if res.Err != nil {
+		return nil, errors.Wrap(res.Err, "failed to start backup")
+	}
+
+	if res.Res == nil {
+		return nil, fmt.Errorf("failed to start backup")
+	}
+
+	return res.Res, nil
+}
+
+// StopBackup releases files pinned by StartBackup, so they can be removed by garbage collector.
+func (r *CommonCartridgeTopology) StopBackup(ctx context.Context, pod *v1.Pod) error {
+	// language=lua
+	lua := `
+		box.backup.stop()
+		return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return errors.Wrap(err, "failed to stop backup")
+	}
+
+	if res.Err != nil {
+		return errors.Wrap(res.Err, "failed to stop backup")
+	}
+
+	return nil
+}
+
+// GetStorageUsage retrieves memtx and vinyl usage of the instance.
+func (r *CommonCartridgeTopology) GetStorageUsage(ctx context.Context, pod *v1.Pod) (*StorageUsage, error) {
+	// language=lua
+	lua := `
+		local slab = box.slab.info()
+		local vinyl = box.stat.vinyl()
+
+		return {
+			res = {
+				memtx_used = slab.arena_used,
+				memtx_limit = slab.quota_size,
+				vinyl_disk = vinyl.disk.data + vinyl.disk.index,
+			},
+			err = nil,
+		}
+	`
+
+	var res *LuaCallResult[*StorageUsage]
+
+	err := r.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve storage usage")
+	}
+
+	if res.Err != nil {
+		return nil, errors.Wrap(res.Err, "unable to retrieve storage usage")
+	}
+
+	return res.Res, nil
+}
+
+// ConfigureElection applies Raft params with box.cfg, only options which differ from actual are changed.
+func (r *CommonCartridgeTopology) ConfigureElection(ctx context.Context, pod *v1.Pod, params *ElectionParams) (*ElectionState, error) {
+	// language=lua
+	lua := `
+		local args = ...
+
+		if box.info.election == nil then
+			return {
+				res = nil,
+				err = { class_name = 'RaftNotSupported', err = 'Raft is not supported by Tarantool ' .. box.info.version },
+			}
+		end
+
+		local cfg = {}
+		if box.cfg.election_mode ~= args.election_mode then
+			cfg.election_mode = args.election_mode
+		end
+		if args.election_timeout ~= nil and box.cfg.election_timeout ~= args.election_timeout then
+			cfg.election_timeout = args.election_timeout
+		end
+		if args.replication_synchro_quorum ~= nil and box.cfg.replication_synchro_quorum ~= args.replication_synchro_quorum then
+			cfg.replication_synchro_quorum = args.replication_synchro_quorum
+		end
+
+		if next(cfg) ~= nil then
+			box.cfg(cfg)
+		end
+
+		local election = box.info.election
+
+		return { res = { state = election.state, term = election.term }, err = nil }
+	`
+
+	var res *LuaCallResult[*ElectionState]
+
+	err := r.Exec(ctx, pod, &res, lua, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to configure election")
+	}
+
+	if res.Err != nil {
+		if res.Err.ClassName == "RaftNotSupported" {
+			return nil, NewRaftNotSupportedError(res.Err)
+		}
+
+		return nil, errors.Wrap(res.Err, "unable to configure election")
+	}
+
+	return res.Res, nil
+}
+
+// SetReplicationParams applies replication options with box.cfg, only options which differ from actual are changed.
+func (r *CommonCartridgeTopology) SetReplicationParams(ctx context.Context, pod *v1.Pod, params *ReplicationParams) error {
+	// language=lua
+	lua := `
+		local args = ...
+
+		local cfg = {}
+		for option, value in pairs(args) do
+			if box.cfg[option] ~= value then
+				cfg[option] = value
+			end
+		end
+
+		if next(cfg) == nil then
+			return { res = true, err = nil }
+		end
+
+		local ok, err = pcall(box.cfg, cfg)
+		if not ok then
+			return { res = nil, err = { class_name = 'ReplicationError', err = tostring(err) } }
+		end
+
+		return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Exec(ctx, pod, &res, lua, params)
+	if err != nil {
+		return errors.Wrap(err, "unable to set replication params")
+	}
+
+	if res.Err != nil {
+		return errors.Wrap(res.Err, "unable to set replication params")
+	}
+
+	return nil
+}
+
+func (r *CommonCartridgeTopology) IsStarted(ctx context.Context, pod *v1.Pod) (bool, error) {
 	// language=lua
 	lua := `
 		local confapplier = require('cartridge.confapplier')
@@ -426,7 +985,7 @@ func (r *CommonCartridgeTopology) IsCartridgeStarted(ctx context.Context, pod *v
 	return res.Res, nil
 }
 
-func (r *CommonCartridgeTopology) IsCartridgeConfigured(ctx context.Context, pod *v1.Pod) (bool, error) {
+func (r *CommonCartridgeTopology) IsConfigured(ctx context.Context, pod *v1.Pod) (bool, error) {
 	// language=lua
 	lua := `
 		local confapplier = require('cartridge.confapplier')
@@ -11,6 +11,7 @@ var (
 	ErrAlreadyJoined     = errors.New("already joined")
 	ErrNotInConfig       = errors.New("not in config")
 	ErrLastStorageWeight = errors.New("at least one vshard-storage (default) must have weight > 0")
+	ErrConfigNotActive   = errors.New("cluster-wide config is not active yet")
 )
 
 type UnknownRoleError struct {
@@ -23,6 +24,39 @@ func NewUnknownRoleError(err *LuaError) *UnknownRoleError {
 	}
 }
 
+// ConfigValidationError is returned when clusterwide config is rejected by application.
+type ConfigValidationError struct {
+	*LuaError
+}
+
+func NewConfigValidationError(err *LuaError) *ConfigValidationError {
+	return &ConfigValidationError{
+		LuaError: err,
+	}
+}
+
+// SchemaValidationError is returned when DDL schema is rejected by cartridge_check_schema.
+type SchemaValidationError struct {
+	*LuaError
+}
+
+func NewSchemaValidationError(err *LuaError) *SchemaValidationError {
+	return &SchemaValidationError{
+		LuaError: err,
+	}
+}
+
+// RaftNotSupportedError is returned when Tarantool of instance has no Raft based leader election.
+type RaftNotSupportedError struct {
+	*LuaError
+}
+
+func NewRaftNotSupportedError(err *LuaError) *RaftNotSupportedError {
+	return &RaftNotSupportedError{
+		LuaError: err,
+	}
+}
+
 func isAlreadyBootstrapped(err *LuaError) bool {
 	return err.ClassName == "Bootstrapping vshard failed" &&
 		strings.Contains(err.Err, "already bootstrapped")
@@ -0,0 +1,121 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport"
+	v1 "k8s.io/api/core/v1"
+)
+
+// CommonPlainTopology provides Replication capability for instances which call box.cfg without Cartridge.
+type CommonPlainTopology struct {
+	Transport transport.Transport
+}
+
+func (r *CommonPlainTopology) ConfigureReplication(ctx context.Context, pod *v1.Pod, replication []string, readOnly bool) (bool, error) {
+	// box.cfg blocks until instance connects to peers, so replication is changed in background fiber
+	// and result is checked on next call. read_only is changed synchronously to demote masters immediately.
+	// language=lua
+	lua := `
+		local args = ...
+		local fiber = require('fiber')
+
+		if type(box.cfg) == 'function' then
+			return { res = false, err = nil }
+		end
+
+		local state = rawget(_G, '__tarantool_operator_replication')
+		if state == nil then
+			state = {}
+			rawset(_G, '__tarantool_operator_replication', state)
+		end
+
+		if state.fiber ~= nil and state.fiber:status() ~= 'dead' then
+			return { res = false, err = nil }
+		end
+
+		if state.err ~= nil then
+			local err = state.err
+			state.err = nil
+			return { res = nil, err = { class_name = 'ReplicationError', err = err } }
+		end
+
+		if args.read_only and box.cfg.read_only ~= true then
+			box.cfg({ read_only = true })
+		end
+
+		local current = box.cfg.replication or {}
+		if type(current) ~= 'table' then
+			current = { current }
+		end
+
+		local same = #current == #args.replication
+		for i, uri in ipairs(args.replication) do
+			same = same and current[i] == uri
+		end
+
+		if not same then
+			state.fiber = fiber.new(function()
+				local ok, err = pcall(box.cfg, { replication = args.replication })
+				if not ok then
+					state.err = tostring(err)
+				end
+			end)
+			return { res = false, err = nil }
+		end
+
+		if not args.read_only and box.cfg.read_only ~= false then
+			box.cfg({ read_only = false })
+		end
+
+		return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Transport.Exec(ctx, pod, &res, lua, ConfigureReplicationQuery{
+		Replication: replication,
+		ReadOnly:    readOnly,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to configure replication")
+	}
+
+	if res.Err != nil {
+		return false, errors.Wrap(res.Err, "unable to configure replication")
+	}
+
+	return res.Res, nil
+}
+
+func (r *CommonPlainTopology) GetVClock(ctx context.Context, pod *v1.Pod) (VClock, error) {
+	// language=lua
+	lua := `
+		if type(box.cfg) == 'function' then
+			return { res = nil, err = { class_name = 'NotConfigured', err = 'box.cfg is not called yet' } }
+		end
+
+		local vclock = setmetatable({}, { __serialize = 'map' })
+		for id, lsn in pairs(box.info.vclock) do
+			if id ~= 0 then
+				vclock[tostring(id)] = lsn
+			end
+		end
+
+		return { res = vclock, err = nil }
+	`
+
+	var res *LuaCallResult[VClock]
+
+	err := r.Transport.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get vclock")
+	}
+
+	if res.Err != nil {
+		return nil, errors.Wrap(res.Err, "unable to get vclock")
+	}
+
+	return res.Res, nil
+}
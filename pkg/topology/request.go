@@ -60,3 +60,27 @@ type StateboardParams struct {
 	URI      string `json:"uri"`
 	Password string `json:"password"`
 }
+
+type ReloadConfigQuery struct {
+	Checksum string `json:"checksum"`
+}
+
+type ConfigureReplicationQuery struct {
+	Replication []string `json:"replication"`
+	ReadOnly    bool     `json:"read_only"`
+}
+
+// ElectionParams type struct for configure Raft of instance with box.cfg.
+type ElectionParams struct {
+	Mode          api.ElectionMode `json:"election_mode"`
+	Timeout       float64          `json:"election_timeout,omitempty"`
+	SynchroQuorum interface{}      `json:"replication_synchro_quorum,omitempty"`
+}
+
+// ReplicationParams type struct for configure replication options of instance with box.cfg.
+type ReplicationParams struct {
+	SynchroQuorum  int32   `json:"replication_synchro_quorum,omitempty"`
+	SynchroTimeout float64 `json:"replication_synchro_timeout,omitempty"`
+	Timeout        float64 `json:"replication_timeout,omitempty"`
+	ConnectQuorum  *int32  `json:"replication_connect_quorum,omitempty"`
+}
@@ -24,6 +24,71 @@ func (r *LuaError) Error() string {
 type (
 	BooleanResult = LuaCallResult[bool]
 	Int64Result   = LuaCallResult[int64]
+	StringResult  = LuaCallResult[string]
 )
 
-type CartridgeConfigData = map[string]interface{}
+type ClusterwideConfigData = map[string]interface{}
+
+// ServerInfo describes a server of replicaset as it is known by cartridge.
+type ServerInfo struct {
+	UUID  string `json:"uuid"`
+	URI   string `json:"uri"`
+	Alias string `json:"alias,omitempty"`
+}
+
+// ReplicasetInfo describes a replicaset as it is known by cartridge.
+type ReplicasetInfo struct {
+	UUID        string       `json:"uuid"`
+	Alias       string       `json:"alias"`
+	Roles       []string     `json:"roles"`
+	Weight      float64      `json:"weight,omitempty"`
+	AllRw       bool         `json:"all_rw,omitempty"`
+	VshardGroup string       `json:"vshard_group,omitempty"`
+	MasterURI   string       `json:"master_uri"`
+	Servers     []ServerInfo `json:"servers"`
+}
+
+// VClock is a vector clock of instance, box.info.vclock without local component of id 0 which is not replicated.
+type VClock map[uint32]uint64
+
+// Covers reports whether all changes known by other vclock are applied by instance with this vclock.
+func (r VClock) Covers(other VClock) bool {
+	for id, lsn := range other {
+		if r[id] < lsn {
+			return false
+		}
+	}
+
+	return true
+}
+
+// StorageUsage describes memory and disk used by storage engines of instance.
+type StorageUsage struct {
+	// MemtxUsed is a memory used by tuples and indexes of memtx, box.slab.info().arena_used
+	MemtxUsed int64 `json:"memtx_used"`
+	// MemtxLimit is a memtx quota, box.slab.info().quota_size
+	MemtxLimit int64 `json:"memtx_limit"`
+	// VinylDisk is a disk space used by vinyl data and indexes
+	VinylDisk int64 `json:"vinyl_disk"`
+}
+
+// TopologyState describes a runtime state of cluster topology which is changed by failover.
+type TopologyState struct {
+	// ActiveLeaders maps replicaset UUID to UUID of its active leader
+	ActiveLeaders map[string]string `json:"active_leaders"`
+	// Members maps advertise URI of instance to its membership status
+	Members map[string]string `json:"members"`
+}
+
+// BackupFiles describes files which are pinned by box.backup.start.
+type BackupFiles struct {
+	Workdir string   `json:"workdir"`
+	Files   []string `json:"files"`
+}
+
+// ElectionState describes Raft state of instance, box.info.election.
+type ElectionState struct {
+	// State is one of follower, candidate or leader
+	State string `json:"state"`
+	Term  int64  `json:"term"`
+}
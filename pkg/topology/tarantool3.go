@@ -0,0 +1,89 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tarantool/tarantool-operator/pkg/topology/transport"
+	v1 "k8s.io/api/core/v1"
+)
+
+// CommonTarantool3Topology provides DeclarativeConfig capability for instances of Tarantool 3.x,
+// which topology is defined by cluster config.
+type CommonTarantool3Topology struct {
+	Transport transport.Transport
+}
+
+func (r *CommonTarantool3Topology) ReloadConfig(ctx context.Context, pod *v1.Pod, checksum string) (bool, error) {
+	// language=lua
+	lua := `
+		local args = ...
+		local config = require('config')
+		local digest = require('digest')
+		local fio = require('fio')
+
+		local file, err = fio.open(os.getenv('TT_CONFIG'), {'O_RDONLY'})
+		if file == nil then
+			return { res = nil, err = { class_name = 'ConfigError', err = tostring(err) } }
+		end
+		local content = file:read()
+		file:close()
+
+		if digest.md5_hex(content) ~= args.checksum then
+			return { res = false, err = nil }
+		end
+
+		config:reload()
+
+		local info = config:info()
+		if info.status == 'check_errors' then
+			local messages = {}
+			for _, alert in ipairs(info.alerts) do
+				table.insert(messages, alert.message)
+			end
+			return { res = nil, err = { class_name = 'ConfigError', err = table.concat(messages, '; ') } }
+		end
+
+		return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Transport.Exec(ctx, pod, &res, lua, ReloadConfigQuery{Checksum: checksum})
+	if err != nil {
+		return false, errors.Wrap(err, "unable to reload config")
+	}
+
+	if res.Err != nil {
+		return false, errors.Wrap(res.Err, "unable to reload config")
+	}
+
+	return res.Res, nil
+}
+
+func (r *CommonTarantool3Topology) BootstrapVShard(ctx context.Context, pod *v1.Pod) error {
+	// language=lua
+	lua := `
+		local vshard = require('vshard')
+
+		local ok, err = vshard.router.bootstrap({ if_not_bootstrapped = true })
+		if not ok then
+			return { res = nil, err = { class_name = 'VShardError', err = tostring(err) } }
+		end
+
+		return { res = true, err = nil }
+	`
+
+	var res *BooleanResult
+
+	err := r.Transport.Exec(ctx, pod, &res, lua)
+	if err != nil {
+		return errors.Wrap(err, "unable to bootstrap vshard")
+	}
+
+	if res.Err != nil {
+		return errors.Wrap(res.Err, "unable to bootstrap vshard")
+	}
+
+	return nil
+}
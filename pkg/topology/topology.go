@@ -6,10 +6,13 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
-// CartridgeTopology .
-type CartridgeTopology interface {
+// Executor runs lua code on instance.
+type Executor interface {
 	Exec(ctx context.Context, instance *v1.Pod, res interface{}, lua string, args ...interface{}) error
+}
 
+// Membership manages instances and replicasets joined into topology and reports readiness of instances.
+type Membership interface {
 	Join(
 		ctx context.Context,
 		leader *v1.Pod,
@@ -21,23 +24,103 @@ type CartridgeTopology interface {
 		replicasetIsAllRw bool,
 		advertiseURI string,
 	) error
+	ExpelReplicaset(ctx context.Context, leader *v1.Pod, replicasetUUID string) error
 
 	GetInstanceUUID(ctx context.Context, pod *v1.Pod) (string, error)
+	GetReplicasets(ctx context.Context, leader *v1.Pod) ([]ReplicasetInfo, error)
+	GetTopologyState(ctx context.Context, leader *v1.Pod) (*TopologyState, error)
 
-	SetWeight(ctx context.Context, leader *v1.Pod, replicasetUUID string, replicaWeight int32) error
+	// IsStarted returns true when instance is able to accept topology requests
+	IsStarted(ctx context.Context, pod *v1.Pod) (bool, error)
+	// IsConfigured returns true when instance is joined into topology and its config is applied
+	IsConfigured(ctx context.Context, pod *v1.Pod) (bool, error)
+}
+
+// ReplicasetConfig manages roles enabled on replicasets.
+type ReplicasetConfig interface {
+	GetRolesHierarchy(ctx context.Context, leader *v1.Pod) (map[string][]string, error)
 	GetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string) ([]string, error)
 	SetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string, roles []string) error
+}
 
+// Sharding manages vshard of cluster.
+type Sharding interface {
+	SetWeight(ctx context.Context, leader *v1.Pod, replicasetUUID string, replicaWeight int32) error
 	BootstrapVshard(ctx context.Context, leader *v1.Pod) error
+	// GetBucketsCount returns number of buckets stored by replicaset of instance
+	GetBucketsCount(ctx context.Context, pod *v1.Pod) (int64, error)
+}
 
-	GetRolesHierarchy(ctx context.Context, leader *v1.Pod) (map[string][]string, error)
-
+// Failover manages failover of cluster and priority of instances in replicasets.
+type Failover interface {
 	SetFailoverParams(ctx context.Context, leader *v1.Pod, params *FailoverParams) error
 	GetFailoverParams(ctx context.Context, leader *v1.Pod) (*FailoverParams, error)
+	SetFailoverPriority(ctx context.Context, leader *v1.Pod, replicasetUUID string, instanceUUIDs []string) error
+}
+
+// ClusterwideConfig manages config and DDL schema shared by all instances of cluster.
+type ClusterwideConfig interface {
+	GetConfig(ctx context.Context, leader *v1.Pod) (ClusterwideConfigData, error)
+	ValidateConfig(ctx context.Context, leader *v1.Pod, config ClusterwideConfigData) error
+	ApplyConfig(ctx context.Context, leader *v1.Pod, config ClusterwideConfigData) error
 
-	GetCartridgeConfig(ctx context.Context, leader *v1.Pod) (CartridgeConfigData, error)
-	ApplyCartridgeConfig(ctx context.Context, leader *v1.Pod, config CartridgeConfigData) error
+	GetSchema(ctx context.Context, leader *v1.Pod) (string, error)
+	CheckSchema(ctx context.Context, leader *v1.Pod, schema string) error
+	ApplySchema(ctx context.Context, leader *v1.Pod, schema string) error
+}
 
-	IsCartridgeStarted(ctx context.Context, pod *v1.Pod) (bool, error)
-	IsCartridgeConfigured(ctx context.Context, pod *v1.Pod) (bool, error)
+// Backup pins snapshot files of instance while they are copied.
+type Backup interface {
+	StartBackup(ctx context.Context, pod *v1.Pod) (*BackupFiles, error)
+	StopBackup(ctx context.Context, pod *v1.Pod) error
+}
+
+// Storage reports usage of storage engines of instance.
+type Storage interface {
+	GetStorageUsage(ctx context.Context, pod *v1.Pod) (*StorageUsage, error)
+}
+
+// Election manages Raft based leader election of instances used by "raft" failover mode.
+type Election interface {
+	// ConfigureElection changes box.cfg of instance if it differs from params and returns Raft state of instance,
+	// RaftNotSupportedError is returned when Tarantool of instance has no Raft
+	ConfigureElection(ctx context.Context, pod *v1.Pod, params *ElectionParams) (*ElectionState, error)
+}
+
+// ReplicationOptions manages box.cfg replication options of instances.
+type ReplicationOptions interface {
+	// SetReplicationParams changes box.cfg of instance if it differs from params
+	SetReplicationParams(ctx context.Context, pod *v1.Pod, params *ReplicationParams) error
+}
+
+// Replication manages box.cfg replication of instances which are not managed by Cartridge.
+type Replication interface {
+	// ConfigureReplication sets replication peers and read_only mode of instance,
+	// it returns false until box.cfg is called by instance and changes are applied
+	ConfigureReplication(ctx context.Context, pod *v1.Pod, replication []string, readOnly bool) (bool, error)
+	// GetVClock returns vclock of instance, it fails when instance is unreachable or box.cfg is not called yet
+	GetVClock(ctx context.Context, pod *v1.Pod) (VClock, error)
+}
+
+// DeclarativeConfig reloads cluster config which is mounted into pods of instances.
+type DeclarativeConfig interface {
+	// ReloadConfig reloads cluster config of instance when config file mounted into its pod has expected md5 checksum,
+	// it returns false when kubelet has not updated the file yet
+	ReloadConfig(ctx context.Context, pod *v1.Pod, checksum string) (bool, error)
+	// BootstrapVShard bootstraps buckets of vshard from router instance unless they are already bootstrapped
+	BootstrapVShard(ctx context.Context, pod *v1.Pod) error
+}
+
+// CartridgeTopology is a backend of Cartridge applications, it provides all capabilities.
+type CartridgeTopology interface {
+	Executor
+	Membership
+	ReplicasetConfig
+	Sharding
+	Failover
+	ClusterwideConfig
+	Backup
+	Storage
+	Election
+	ReplicationOptions
 }
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+)
+
+// Console runs lua on instances without Cartridge through console socket with tarantoolctl connect.
+type Console struct {
+	TarantoolCTL
+
+	// SocketPath is a path of console socket of instance
+	SocketPath string
+}
+
+func (r *Console) CreateCommand(lua string, args ...any) (*Command, error) {
+	command, err := r.TarantoolCTL.CreateCommand(lua, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Command = []string{
+		"sh",
+		"-c",
+		fmt.Sprintf("cat /dev/stdin | tarantoolctl connect %s", r.SocketPath),
+	}
+
+	return command, nil
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"fmt"
+)
+
+// TT runs lua on Tarantool 3.x instances through console socket with tt connect,
+// which prints results in the same YAML format as tarantoolctl.
+type TT struct {
+	TarantoolCTL
+
+	// SocketPath is a path of console socket of instance
+	SocketPath string
+}
+
+func (r *TT) CreateCommand(lua string, args ...any) (*Command, error) {
+	command, err := r.TarantoolCTL.CreateCommand(lua, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Command = []string{
+		"sh",
+		"-c",
+		fmt.Sprintf("tt connect %s -f -", r.SocketPath),
+	}
+
+	return command, nil
+}
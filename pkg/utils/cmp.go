@@ -1,6 +1,11 @@
 package utils
 
-import "github.com/google/go-cmp/cmp"
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+)
 
 func IsMapSubset(set, subset map[string]any) bool {
 	if len(subset) > len(set) {
@@ -20,3 +25,114 @@ func IsMapSubset(set, subset map[string]any) bool {
 
 	return true
 }
+
+// IsSubset reports whether every field of subset is set to the same value in set, nested maps and lists
+// are compared recursively, so fields which are present only in set, e.g. defaulted by API server, are ignored.
+func IsSubset(set, subset any) bool {
+	switch subset := subset.(type) {
+	case map[string]any:
+		setMap, ok := set.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for k, subsetValue := range subset {
+			if !IsSubset(setMap[k], subsetValue) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		setList, ok := set.([]any)
+		if !ok || len(setList) != len(subset) {
+			return false
+		}
+
+		for k, subsetValue := range subset {
+			if !IsSubset(setList[k], subsetValue) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return cmp.Equal(set, subset)
+	}
+}
+
+// DiffMaps returns sorted paths of keys of subset whose values differ from values of set.
+// Nested maps are compared recursively and their keys are joined with a dot.
+// Values are normalized through json before comparison,
+// so numbers decoded from yaml are equal to the same numbers decoded from json.
+func DiffMaps(set, subset map[string]any) []string {
+	normalizedSet := normalizeMap(set)
+	normalizedSubset := normalizeMap(subset)
+
+	diff := make([]string, 0)
+
+	for k, subsetValue := range normalizedSubset {
+		setValue, found := normalizedSet[k]
+		if !found {
+			diff = append(diff, k)
+
+			continue
+		}
+
+		diff = append(diff, diffValues(k, setValue, subsetValue)...)
+	}
+
+	sort.Strings(diff)
+
+	return diff
+}
+
+func diffValues(path string, a, b any) []string {
+	aMap, aIsMap := a.(map[string]any)
+	bMap, bIsMap := b.(map[string]any)
+
+	if !aIsMap || !bIsMap {
+		if cmp.Equal(a, b) {
+			return nil
+		}
+
+		return []string{path}
+	}
+
+	var diff []string
+
+	for k, aValue := range aMap {
+		bValue, found := bMap[k]
+		if !found {
+			diff = append(diff, path+"."+k)
+
+			continue
+		}
+
+		diff = append(diff, diffValues(path+"."+k, aValue, bValue)...)
+	}
+
+	for k := range bMap {
+		if _, found := aMap[k]; !found {
+			diff = append(diff, path+"."+k)
+		}
+	}
+
+	return diff
+}
+
+func normalizeMap(m map[string]any) map[string]any {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return m
+	}
+
+	normalized := map[string]any{}
+
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return m
+	}
+
+	return normalized
+}
@@ -52,4 +52,63 @@ var _ = Describe("cmp utils unit testing", func() {
 			"new_key": map[string]any{"val": 2},
 		}),
 	)
+
+	DescribeTable(
+		"should return paths of changed keys",
+		func(set, subset map[string]any, expected []string) {
+			Expect(utils.DiffMaps(set, subset)).Should(Equal(expected))
+		},
+		Entry("Empty and empty", map[string]any{}, map[string]any{}, []string{}),
+		Entry("Ignores sections absent in subset", map[string]any{
+			"key":     map[string]any{"val": 1},
+			"key.yml": "{\"val\": 1}",
+		}, map[string]any{
+			"key": map[string]any{"val": 1},
+		}, []string{}),
+		Entry("Numbers of different types are equal", map[string]any{
+			"key": map[string]any{"val": float64(1)},
+		}, map[string]any{
+			"key": map[string]any{"val": 1},
+		}, []string{}),
+		Entry("New section", map[string]any{
+			"key": map[string]any{"val": 1},
+		}, map[string]any{
+			"new_key": map[string]any{"val": 1},
+		}, []string{"new_key"}),
+		Entry("Changed, added and removed nested keys", map[string]any{
+			"key": map[string]any{"val": 1, "removed": true, "nested": map[string]any{"a": "b"}},
+		}, map[string]any{
+			"key": map[string]any{"val": 2, "added": true, "nested": map[string]any{"a": "c"}},
+		}, []string{"key.added", "key.nested.a", "key.removed", "key.val"}),
+		Entry("Changed scalar section", map[string]any{
+			"key": "value",
+		}, map[string]any{
+			"key": map[string]any{"val": 1},
+		}, []string{"key"}),
+	)
+
+	DescribeTable(
+		"should compare only fields of subset",
+		func(set, subset any, expected bool) {
+			Expect(utils.IsSubset(set, subset)).Should(Equal(expected))
+		},
+		Entry("defaulted field of list item", map[string]any{
+			"endpoints": []any{map[string]any{"port": "metrics", "scheme": "http"}},
+		}, map[string]any{
+			"endpoints": []any{map[string]any{"port": "metrics"}},
+		}, true),
+		Entry("changed field of list item", map[string]any{
+			"endpoints": []any{map[string]any{"port": "metrics", "scheme": "http"}},
+		}, map[string]any{
+			"endpoints": []any{map[string]any{"port": "http"}},
+		}, false),
+		Entry("extra list item", map[string]any{
+			"labels": []any{"cluster", "role", "replicaset"},
+		}, map[string]any{
+			"labels": []any{"cluster", "role"},
+		}, false),
+		Entry("missing field", map[string]any{}, map[string]any{
+			"path": "/metrics",
+		}, false),
+	)
 })
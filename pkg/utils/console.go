@@ -0,0 +1,50 @@
+package utils
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ConsoleRunVolumeName is a name of volume with console socket added by ApplyConsoleSocket.
+	ConsoleRunVolumeName = "tarantool-run"
+	ConsoleRunDir        = "/var/run/tarantool"
+	ConsoleSocket        = ConsoleRunDir + "/tarantool.control"
+)
+
+// ApplyConsoleSocket shares directory of console socket of instances without Cartridge with emptyDir volume
+// and passes path of socket in TARANTOOL_CONSOLE_SOCKET, operator executes lua through this socket.
+// Variables already defined in container are kept as is, so user-provided values take precedence.
+func ApplyConsoleSocket(spec *v1.PodSpec) {
+	applyConsoleRunDir(spec)
+
+	for k := range spec.Containers {
+		container := &spec.Containers[k]
+
+		if !hasEnvVar(container.Env, "TARANTOOL_CONSOLE_SOCKET") {
+			container.Env = append(container.Env, v1.EnvVar{Name: "TARANTOOL_CONSOLE_SOCKET", Value: ConsoleSocket})
+		}
+	}
+}
+
+// applyConsoleRunDir shares directory of console socket between containers and operator with emptyDir volume.
+func applyConsoleRunDir(spec *v1.PodSpec) {
+	if !hasVolume(spec.Volumes, ConsoleRunVolumeName) {
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: ConsoleRunVolumeName,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
+	for k := range spec.Containers {
+		container := &spec.Containers[k]
+
+		if !hasVolumeMount(container.VolumeMounts, ConsoleRunVolumeName) {
+			container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+				Name:      ConsoleRunVolumeName,
+				MountPath: ConsoleRunDir,
+			})
+		}
+	}
+}
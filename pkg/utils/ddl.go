@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+type ddlSchema struct {
+	Spaces map[string]ddlSpace `yaml:"spaces"`
+}
+
+type ddlSpace struct {
+	Engine  string     `yaml:"engine"`
+	Format  []ddlField `yaml:"format"`
+	Indexes []ddlIndex `yaml:"indexes"`
+}
+
+type ddlField struct {
+	Name       string `yaml:"name"`
+	Type       string `yaml:"type"`
+	IsNullable bool   `yaml:"is_nullable"`
+}
+
+type ddlIndex struct {
+	Name string `yaml:"name"`
+}
+
+// DestructiveSchemaChanges compares two DDL schemas in yaml and describes changes of desired schema
+// which may lead to data loss: removed spaces, fields and indexes, changed engines of spaces,
+// changed types of fields and fields which are not nullable anymore.
+func DestructiveSchemaChanges(actual, desired string) ([]string, error) {
+	var actualSchema, desiredSchema ddlSchema
+
+	err := yaml.Unmarshal([]byte(actual), &actualSchema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse actual schema: %w", err)
+	}
+
+	err = yaml.Unmarshal([]byte(desired), &desiredSchema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse desired schema: %w", err)
+	}
+
+	spaceNames := make([]string, 0, len(actualSchema.Spaces))
+	for name := range actualSchema.Spaces {
+		spaceNames = append(spaceNames, name)
+	}
+
+	sort.Strings(spaceNames)
+
+	changes := make([]string, 0)
+
+	for _, spaceName := range spaceNames {
+		actualSpace := actualSchema.Spaces[spaceName]
+
+		desiredSpace, ok := desiredSchema.Spaces[spaceName]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("space %s is removed", spaceName))
+
+			continue
+		}
+
+		if actualSpace.Engine != desiredSpace.Engine {
+			changes = append(changes, fmt.Sprintf("engine of space %s is changed from %s to %s", spaceName, actualSpace.Engine, desiredSpace.Engine))
+		}
+
+		changes = append(changes, destructiveFieldChanges(spaceName, actualSpace.Format, desiredSpace.Format)...)
+		changes = append(changes, destructiveIndexChanges(spaceName, actualSpace.Indexes, desiredSpace.Indexes)...)
+	}
+
+	return changes, nil
+}
+
+func destructiveFieldChanges(spaceName string, actual, desired []ddlField) []string {
+	desiredFields := make(map[string]ddlField, len(desired))
+	for _, field := range desired {
+		desiredFields[field.Name] = field
+	}
+
+	var changes []string
+
+	for _, actualField := range actual {
+		desiredField, ok := desiredFields[actualField.Name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("field %s.%s is removed", spaceName, actualField.Name))
+
+			continue
+		}
+
+		if actualField.Type != desiredField.Type {
+			changes = append(changes, fmt.Sprintf("type of field %s.%s is changed from %s to %s", spaceName, actualField.Name, actualField.Type, desiredField.Type))
+		}
+
+		if actualField.IsNullable && !desiredField.IsNullable {
+			changes = append(changes, fmt.Sprintf("field %s.%s is not nullable anymore", spaceName, actualField.Name))
+		}
+	}
+
+	return changes
+}
+
+func destructiveIndexChanges(spaceName string, actual, desired []ddlIndex) []string {
+	desiredIndexes := make(map[string]bool, len(desired))
+	for _, index := range desired {
+		desiredIndexes[index.Name] = true
+	}
+
+	var changes []string
+
+	for _, actualIndex := range actual {
+		if !desiredIndexes[actualIndex.Name] {
+			changes = append(changes, fmt.Sprintf("index %s.%s is removed", spaceName, actualIndex.Name))
+		}
+	}
+
+	return changes
+}
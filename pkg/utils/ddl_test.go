@@ -0,0 +1,84 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+const actualSchema = `
+spaces:
+  customer:
+    engine: memtx
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+      - {name: bucket_id, type: unsigned, is_nullable: false}
+      - {name: name, type: string, is_nullable: true}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: unsigned, is_nullable: false}]}
+      - {name: bucket_id, type: TREE, unique: false, parts: [{path: bucket_id, type: unsigned, is_nullable: false}]}
+`
+
+var _ = Describe("ddl utils unit testing", func() {
+	DescribeTable(
+		"should detect destructive schema changes",
+		func(actual, desired string, expected []string) {
+			changes, err := utils.DestructiveSchemaChanges(actual, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).Should(Equal(expected))
+		},
+		Entry("Empty and empty", "", "", []string{}),
+		Entry("Same schema", actualSchema, actualSchema, []string{}),
+		Entry("New space and field", actualSchema, `
+spaces:
+  customer:
+    engine: memtx
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+      - {name: bucket_id, type: unsigned, is_nullable: false}
+      - {name: name, type: string, is_nullable: true}
+      - {name: email, type: string, is_nullable: true}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: unsigned, is_nullable: false}]}
+      - {name: bucket_id, type: TREE, unique: false, parts: [{path: bucket_id, type: unsigned, is_nullable: false}]}
+  account:
+    engine: vinyl
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+`, []string{}),
+		Entry("Removed space", actualSchema, "spaces: {}", []string{"space customer is removed"}),
+		Entry("Changed space", actualSchema, `
+spaces:
+  customer:
+    engine: vinyl
+    format:
+      - {name: id, type: string, is_nullable: false}
+      - {name: bucket_id, type: unsigned, is_nullable: false}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: string, is_nullable: false}]}
+`, []string{
+			"engine of space customer is changed from memtx to vinyl",
+			"type of field customer.id is changed from unsigned to string",
+			"field customer.name is removed",
+			"index customer.bucket_id is removed",
+		}),
+		Entry("Not nullable field", actualSchema, `
+spaces:
+  customer:
+    engine: memtx
+    format:
+      - {name: id, type: unsigned, is_nullable: false}
+      - {name: bucket_id, type: unsigned, is_nullable: false}
+      - {name: name, type: string, is_nullable: false}
+    indexes:
+      - {name: primary, type: TREE, unique: true, parts: [{path: id, type: unsigned, is_nullable: false}]}
+      - {name: bucket_id, type: TREE, unique: false, parts: [{path: bucket_id, type: unsigned, is_nullable: false}]}
+`, []string{"field customer.name is not nullable anymore"}),
+	)
+
+	It("should fail on malformed schema", func() {
+		_, err := utils.DestructiveSchemaChanges(actualSchema, "spaces: [")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -23,3 +25,10 @@ func HashObject(data interface{}) (string, error) {
 
 	return rand.SafeEncodeString(fmt.Sprint(hashFunc.Sum32())), nil
 }
+
+// MD5 returns md5 checksum of data in hex, it is used where checksum is compared on Tarantool side.
+func MD5(data []byte) string {
+	sum := md5.Sum(data)
+
+	return hex.EncodeToString(sum[:])
+}
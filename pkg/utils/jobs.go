@@ -0,0 +1,27 @@
+package utils
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// IsJobComplete returns true if a job finished successfully.
+func IsJobComplete(job *batchv1.Job) bool {
+	return IsJobConditionTrue(job, batchv1.JobComplete)
+}
+
+// IsJobFailed returns true if a job failed and will not be retried.
+func IsJobFailed(job *batchv1.Job) bool {
+	return IsJobConditionTrue(job, batchv1.JobFailed)
+}
+
+// IsJobConditionTrue returns true if the provided condition of a job is true.
+func IsJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
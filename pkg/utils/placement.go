@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PlacementWeight is a weight of preferred pod anti-affinity term added by ApplyPlacement.
+const PlacementWeight = 100
+
+// ApplyPlacement adds pod anti-affinity and topology spread constraint which spread pods matching selector
+// across domains of topologyKey. Terms and constraints already defined in pod spec for the same topology key
+// and selector are kept as is, so user-provided affinity takes precedence.
+func ApplyPlacement(spec *v1.PodSpec, topologyKey string, required bool, selector *metav1.LabelSelector) {
+	term := v1.PodAffinityTerm{
+		LabelSelector: selector,
+		TopologyKey:   topologyKey,
+	}
+
+	if spec.Affinity == nil {
+		spec.Affinity = &v1.Affinity{}
+	}
+
+	if spec.Affinity.PodAntiAffinity == nil {
+		spec.Affinity.PodAntiAffinity = &v1.PodAntiAffinity{}
+	}
+
+	antiAffinity := spec.Affinity.PodAntiAffinity
+
+	if required {
+		if !hasPodAffinityTerm(antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term) {
+			antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+				antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+				term,
+			)
+		}
+	} else {
+		terms := make([]v1.PodAffinityTerm, len(antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution))
+		for k, weighted := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms[k] = weighted.PodAffinityTerm
+		}
+
+		if !hasPodAffinityTerm(terms, term) {
+			antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+				antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				v1.WeightedPodAffinityTerm{
+					Weight:          PlacementWeight,
+					PodAffinityTerm: term,
+				},
+			)
+		}
+	}
+
+	for _, constraint := range spec.TopologySpreadConstraints {
+		if constraint.TopologyKey == topologyKey && cmp.Equal(constraint.LabelSelector, selector) {
+			return
+		}
+	}
+
+	whenUnsatisfiable := v1.ScheduleAnyway
+	if required {
+		whenUnsatisfiable = v1.DoNotSchedule
+	}
+
+	spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, v1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       topologyKey,
+		WhenUnsatisfiable: whenUnsatisfiable,
+		LabelSelector:     selector,
+	})
+}
+
+func hasPodAffinityTerm(terms []v1.PodAffinityTerm, term v1.PodAffinityTerm) bool {
+	for _, existing := range terms {
+		if existing.TopologyKey == term.TopologyKey && cmp.Equal(existing.LabelSelector, term.LabelSelector) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("placement utils unit testing", func() {
+	var selector *metav1.LabelSelector
+
+	BeforeEach(func() {
+		selector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tarantool.io/replicaset-name": "storage-0"},
+		}
+	})
+
+	It("must add required anti-affinity and strict spread constraint", func() {
+		spec := &v1.PodSpec{}
+		utils.ApplyPlacement(spec, v1.LabelHostname, true, selector)
+
+		terms := spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		Expect(terms).To(HaveLen(1))
+		Expect(terms[0].TopologyKey).To(Equal(v1.LabelHostname))
+		Expect(terms[0].LabelSelector).To(Equal(selector))
+		Expect(spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(BeEmpty())
+
+		Expect(spec.TopologySpreadConstraints).To(HaveLen(1))
+		Expect(spec.TopologySpreadConstraints[0].WhenUnsatisfiable).To(Equal(v1.DoNotSchedule))
+	})
+
+	It("must add preferred anti-affinity and soft spread constraint", func() {
+		spec := &v1.PodSpec{}
+		utils.ApplyPlacement(spec, v1.LabelTopologyZone, false, selector)
+
+		terms := spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		Expect(terms).To(HaveLen(1))
+		Expect(terms[0].Weight).To(Equal(int32(utils.PlacementWeight)))
+		Expect(terms[0].PodAffinityTerm.TopologyKey).To(Equal(v1.LabelTopologyZone))
+
+		Expect(spec.TopologySpreadConstraints).To(HaveLen(1))
+		Expect(spec.TopologySpreadConstraints[0].WhenUnsatisfiable).To(Equal(v1.ScheduleAnyway))
+	})
+
+	It("must keep user-provided affinity", func() {
+		spec := &v1.PodSpec{
+			Affinity: &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{},
+				PodAntiAffinity: &v1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+						{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+							TopologyKey:   v1.LabelHostname,
+						},
+					},
+				},
+			},
+			TopologySpreadConstraints: []v1.TopologySpreadConstraint{
+				{
+					MaxSkew:           2,
+					TopologyKey:       v1.LabelHostname,
+					WhenUnsatisfiable: v1.ScheduleAnyway,
+					LabelSelector:     selector,
+				},
+			},
+		}
+
+		utils.ApplyPlacement(spec, v1.LabelHostname, true, selector)
+		utils.ApplyPlacement(spec, v1.LabelHostname, true, selector)
+
+		Expect(spec.Affinity.NodeAffinity).NotTo(BeNil())
+		Expect(spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(HaveLen(2))
+		Expect(spec.TopologySpreadConstraints).To(HaveLen(1))
+		Expect(spec.TopologySpreadConstraints[0].MaxSkew).To(Equal(int32(2)))
+	})
+})
@@ -1,6 +1,19 @@
 package utils
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// PodNameFromURI extracts pod name from advertise uri of instance,
+// uri has the form <pod>.<cluster>.<namespace>.svc.<domain>:<port>.
+func PodNameFromURI(uri string) string {
+	host, _, _ := strings.Cut(uri, ":")
+	name, _, _ := strings.Cut(host, ".")
+
+	return name
+}
 
 func IsPodDeleting(pod *v1.Pod) bool {
 	return pod.DeletionTimestamp != nil
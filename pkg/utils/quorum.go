@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EvalQuorum evaluates formula of quorum like "N / 2 + 1", where N is a number of instances in replicaset.
+// Formula consists of integers, N, +, -, *, / and parentheses, division is integer as in Tarantool.
+// Result is bounded to [1, n], so quorum is always reachable by all instances.
+func EvalQuorum(formula string, n int32) (int32, error) {
+	parser := &quorumParser{
+		input: strings.ReplaceAll(formula, " ", ""),
+		n:     int64(n),
+	}
+
+	value, err := parser.parseSum()
+	if err != nil {
+		return 0, err
+	}
+
+	if parser.pos < len(parser.input) {
+		return 0, fmt.Errorf("unexpected %q at %d in quorum formula %q", parser.input[parser.pos], parser.pos, formula)
+	}
+
+	if value < 1 {
+		return 1, nil
+	}
+
+	if value > int64(n) && n > 0 {
+		return n, nil
+	}
+
+	return int32(value), nil
+}
+
+type quorumParser struct {
+	input string
+	pos   int
+	n     int64
+}
+
+func (p *quorumParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+
+	return 0
+}
+
+func (p *quorumParser) parseSum() (int64, error) {
+	value, err := p.parseProduct()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peek() == '+' || p.peek() == '-' {
+		op := p.peek()
+		p.pos++
+
+		right, err := p.parseProduct()
+		if err != nil {
+			return 0, err
+		}
+
+		if op == '+' {
+			value += right
+		} else {
+			value -= right
+		}
+	}
+
+	return value, nil
+}
+
+func (p *quorumParser) parseProduct() (int64, error) {
+	value, err := p.parseOperand()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peek() == '*' || p.peek() == '/' {
+		op := p.peek()
+		p.pos++
+
+		right, err := p.parseOperand()
+		if err != nil {
+			return 0, err
+		}
+
+		if op == '*' {
+			value *= right
+
+			continue
+		}
+
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero in quorum formula %q", p.input)
+		}
+
+		value /= right
+	}
+
+	return value, nil
+}
+
+func (p *quorumParser) parseOperand() (int64, error) {
+	switch c := p.peek(); {
+	case c == 'N':
+		p.pos++
+
+		return p.n, nil
+	case c == '(':
+		p.pos++
+
+		value, err := p.parseSum()
+		if err != nil {
+			return 0, err
+		}
+
+		if p.peek() != ')' {
+			return 0, fmt.Errorf("missing ) in quorum formula %q", p.input)
+		}
+
+		p.pos++
+
+		return value, nil
+	case unicode.IsDigit(rune(c)):
+		start := p.pos
+		for unicode.IsDigit(rune(p.peek())) {
+			p.pos++
+		}
+
+		return strconv.ParseInt(p.input[start:p.pos], 10, 64)
+	case c == 0:
+		return 0, fmt.Errorf("unexpected end of quorum formula %q", p.input)
+	default:
+		return 0, fmt.Errorf("unexpected %q at %d in quorum formula %q", c, p.pos, p.input)
+	}
+}
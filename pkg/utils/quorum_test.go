@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+)
+
+var _ = Describe("quorum utils unit testing", func() {
+	It("must evaluate formula with number of instances", func() {
+		for n, expected := range map[int32]int32{1: 1, 2: 2, 3: 2, 4: 3, 5: 3} {
+			quorum, err := utils.EvalQuorum("N / 2 + 1", n)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quorum).To(Equal(expected), "N = %d", n)
+		}
+
+		quorum, err := utils.EvalQuorum("(N+1)*2/3", 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quorum).To(Equal(int32(4)))
+	})
+
+	It("must bound quorum by number of instances", func() {
+		quorum, err := utils.EvalQuorum("N - 3", 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quorum).To(Equal(int32(1)))
+
+		quorum, err = utils.EvalQuorum("N + 1", 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quorum).To(Equal(int32(2)))
+	})
+
+	It("must reject invalid formula", func() {
+		for _, formula := range []string{"", "N /", "(N + 1", "M / 2", "N / 0", "N 2"} {
+			_, err := utils.EvalQuorum(formula, 3)
+			Expect(err).To(HaveOccurred(), "formula %q", formula)
+		}
+	})
+})
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceMonitorGVK is a kind of Prometheus Operator ServiceMonitor,
+// it is used via unstructured objects to not require Prometheus Operator CRDs in the cluster.
+var ServiceMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "ServiceMonitor",
+}
+
+// PodMonitorGVK is a kind of Prometheus Operator PodMonitor, it is used the same way as ServiceMonitorGVK.
+var PodMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "PodMonitor",
+}
+
+// NewServiceMonitor returns empty unstructured ServiceMonitor.
+func NewServiceMonitor() *unstructured.Unstructured {
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(ServiceMonitorGVK)
+
+	return monitor
+}
+
+// NewPodMonitor returns empty unstructured PodMonitor.
+func NewPodMonitor() *unstructured.Unstructured {
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(PodMonitorGVK)
+
+	return monitor
+}
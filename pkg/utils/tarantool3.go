@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ClusterConfigVolumeName is a name of volume with cluster config added by ApplyClusterConfig.
+	ClusterConfigVolumeName = "tarantool-config"
+	ClusterConfigMountPath  = "/etc/tarantool/config"
+	ClusterConfigFile       = "config.yaml"
+)
+
+var envNameRe = regexp.MustCompile(`[^A-Z0-9]`)
+
+// ClusterConfigMapName returns name of ConfigMap with Tarantool 3.x config of cluster.
+func ClusterConfigMapName(cluster string) string {
+	return cluster + "-config"
+}
+
+// PasswordEnvName returns name of environment variable with password of user of Tarantool 3.x cluster config.
+// Variables are not prefixed with TT_, because Tarantool 3.x treats such variables as config options.
+func PasswordEnvName(user string) string {
+	return "TARANTOOL_PASSWORD_" + envNameRe.ReplaceAllString(strings.ToUpper(user), "_")
+}
+
+// ApplyClusterConfig mounts ConfigMap with cluster config into every container of pod spec,
+// sets TT_CONFIG and TT_INSTANCE_NAME and shares directory of console socket with emptyDir volume.
+// Variables already defined in container take precedence.
+func ApplyClusterConfig(spec *v1.PodSpec, configMapName string, env []v1.EnvVar) {
+	if !hasVolume(spec.Volumes, ClusterConfigVolumeName) {
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: ClusterConfigVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: configMapName,
+					},
+				},
+			},
+		})
+	}
+
+	env = append([]v1.EnvVar{
+		{
+			Name:  "TT_CONFIG",
+			Value: path.Join(ClusterConfigMountPath, ClusterConfigFile),
+		},
+		{
+			Name: "TT_INSTANCE_NAME",
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+	}, env...)
+
+	for k := range spec.Containers {
+		container := &spec.Containers[k]
+
+		if !hasVolumeMount(container.VolumeMounts, ClusterConfigVolumeName) {
+			container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+				Name:      ClusterConfigVolumeName,
+				MountPath: ClusterConfigMountPath,
+				ReadOnly:  true,
+			})
+		}
+
+		for _, envVar := range env {
+			if !hasEnvVar(container.Env, envVar.Name) {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+
+	applyConsoleRunDir(spec)
+}
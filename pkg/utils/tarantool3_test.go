@@ -0,0 +1,48 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("tarantool 3 utils unit testing", func() {
+	It("must mount cluster config into every container once", func() {
+		spec := &v1.PodSpec{
+			Containers: []v1.Container{{Name: "pim"}, {Name: "sidecar"}},
+		}
+
+		utils.ApplyClusterConfig(spec, "cluster-config", nil)
+		utils.ApplyClusterConfig(spec, "cluster-config", nil)
+
+		Expect(spec.Volumes).To(HaveLen(2))
+		Expect(spec.Volumes[0].ConfigMap.Name).To(Equal("cluster-config"))
+
+		for _, container := range spec.Containers {
+			Expect(container.VolumeMounts).To(HaveLen(2))
+			Expect(container.Env).To(HaveLen(2))
+			Expect(container.Env).To(ContainElement(v1.EnvVar{Name: "TT_CONFIG", Value: "/etc/tarantool/config/config.yaml"}))
+		}
+	})
+
+	It("must keep environment variables defined by user", func() {
+		spec := &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "pim",
+				Env:  []v1.EnvVar{{Name: "TT_INSTANCE_NAME", Value: "custom"}},
+			}},
+		}
+
+		utils.ApplyClusterConfig(spec, "cluster-config", []v1.EnvVar{{Name: "TARANTOOL_PASSWORD_ADMIN", Value: "secret"}})
+
+		env := spec.Containers[0].Env
+		Expect(env).To(ContainElement(v1.EnvVar{Name: "TT_INSTANCE_NAME", Value: "custom"}))
+		Expect(env).To(ContainElement(v1.EnvVar{Name: "TARANTOOL_PASSWORD_ADMIN", Value: "secret"}))
+		Expect(env).To(HaveLen(3))
+	})
+
+	It("must build environment variable name of password from any user name", func() {
+		Expect(utils.PasswordEnvName("storage-admin.1")).To(Equal("TARANTOOL_PASSWORD_STORAGE_ADMIN_1"))
+	})
+})
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"path"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// TLSVolumeName is a name of volume with certificates added by ApplyTLS.
+	TLSVolumeName = "tarantool-tls"
+
+	TLSCertKey = "tls.crt"
+	TLSKeyKey  = "tls.key"
+	TLSCAKey   = "ca.crt"
+)
+
+// ApplyTLS mounts certificates from Secret into every container of pod spec and enables SSL transport of Cartridge
+// with TARANTOOL_TRANSPORT and TARANTOOL_SSL_* environment variables. Variables already defined in container
+// are kept as is, so user-provided values take precedence.
+func ApplyTLS(spec *v1.PodSpec, secretName string, mountPath string, ciphers string) {
+	MountTLS(spec, secretName, mountPath)
+
+	certFile := path.Join(mountPath, TLSCertKey)
+	keyFile := path.Join(mountPath, TLSKeyKey)
+	caFile := path.Join(mountPath, TLSCAKey)
+
+	env := []v1.EnvVar{
+		{Name: "TARANTOOL_TRANSPORT", Value: "ssl"},
+		{Name: "TARANTOOL_SSL_SERVER_CERT_FILE", Value: certFile},
+		{Name: "TARANTOOL_SSL_SERVER_KEY_FILE", Value: keyFile},
+		{Name: "TARANTOOL_SSL_SERVER_CA_FILE", Value: caFile},
+		{Name: "TARANTOOL_SSL_CLIENT_CERT_FILE", Value: certFile},
+		{Name: "TARANTOOL_SSL_CLIENT_KEY_FILE", Value: keyFile},
+		{Name: "TARANTOOL_SSL_CLIENT_CA_FILE", Value: caFile},
+	}
+
+	if ciphers != "" {
+		env = append(env, v1.EnvVar{Name: "TARANTOOL_SSL_CIPHERS", Value: ciphers})
+	}
+
+	for k := range spec.Containers {
+		container := &spec.Containers[k]
+
+		for _, envVar := range env {
+			if !hasEnvVar(container.Env, envVar.Name) {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+}
+
+// MountTLS mounts certificates from Secret into every container of pod spec without configuring transport,
+// Tarantool 3.x reads paths of certificates from cluster config.
+func MountTLS(spec *v1.PodSpec, secretName string, mountPath string) {
+	if !hasVolume(spec.Volumes, TLSVolumeName) {
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: TLSVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		})
+	}
+
+	for k := range spec.Containers {
+		container := &spec.Containers[k]
+
+		if !hasVolumeMount(container.VolumeMounts, TLSVolumeName) {
+			container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+				Name:      TLSVolumeName,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+		}
+	}
+}
+
+func hasVolume(volumes []v1.Volume, name string) bool {
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasVolumeMount(mounts []v1.VolumeMount, name string) bool {
+	for _, mount := range mounts {
+		if mount.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasEnvVar(env []v1.EnvVar, name string) bool {
+	for _, envVar := range env {
+		if envVar.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
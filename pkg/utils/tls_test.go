@@ -0,0 +1,57 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("tls utils unit testing", func() {
+	It("must mount certificates into every container once", func() {
+		spec := &v1.PodSpec{
+			Containers: []v1.Container{{Name: "pim"}, {Name: "sidecar"}},
+		}
+
+		utils.ApplyTLS(spec, "tarantool-tls", "/tls", "")
+		utils.ApplyTLS(spec, "tarantool-tls", "/tls", "")
+
+		Expect(spec.Volumes).To(HaveLen(1))
+		Expect(spec.Volumes[0].Secret.SecretName).To(Equal("tarantool-tls"))
+
+		for _, container := range spec.Containers {
+			Expect(container.VolumeMounts).To(HaveLen(1))
+			Expect(container.VolumeMounts[0].MountPath).To(Equal("/tls"))
+			Expect(container.Env).To(HaveLen(7), "ciphers must not be set when empty")
+			Expect(container.Env).To(ContainElement(v1.EnvVar{Name: "TARANTOOL_SSL_SERVER_KEY_FILE", Value: "/tls/tls.key"}))
+		}
+	})
+
+	It("must keep environment variables defined by user", func() {
+		spec := &v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "pim",
+				Env:  []v1.EnvVar{{Name: "TARANTOOL_SSL_SERVER_CA_FILE", Value: "/custom/ca.crt"}},
+			}},
+		}
+
+		utils.ApplyTLS(spec, "tarantool-tls", "/tls", "HIGH")
+
+		env := spec.Containers[0].Env
+		Expect(env).To(ContainElement(v1.EnvVar{Name: "TARANTOOL_SSL_SERVER_CA_FILE", Value: "/custom/ca.crt"}))
+		Expect(env).NotTo(ContainElement(v1.EnvVar{Name: "TARANTOOL_SSL_SERVER_CA_FILE", Value: "/tls/ca.crt"}))
+		Expect(env).To(ContainElement(v1.EnvVar{Name: "TARANTOOL_SSL_CIPHERS", Value: "HIGH"}))
+	})
+
+	It("must only mount certificates for Tarantool 3.x", func() {
+		spec := &v1.PodSpec{
+			Containers: []v1.Container{{Name: "pim"}},
+		}
+
+		utils.MountTLS(spec, "tarantool-tls", "/tls")
+
+		Expect(spec.Volumes).To(HaveLen(1))
+		Expect(spec.Containers[0].VolumeMounts).To(HaveLen(1))
+		Expect(spec.Containers[0].Env).To(BeEmpty())
+	})
+})
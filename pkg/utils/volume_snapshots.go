@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VolumeSnapshotGVK is a kind of CSI VolumeSnapshot,
+// it is used via unstructured objects to not require snapshot CRDs in the cluster.
+var VolumeSnapshotGVK = schema.GroupVersionKind{
+	Group:   "snapshot.storage.k8s.io",
+	Version: "v1",
+	Kind:    "VolumeSnapshot",
+}
+
+// NewVolumeSnapshot returns empty unstructured VolumeSnapshot.
+func NewVolumeSnapshot() *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+
+	return snapshot
+}
+
+// IsVolumeSnapshotReady returns true if a snapshot is ready to be used to provision volumes.
+func IsVolumeSnapshotReady(snapshot *unstructured.Unstructured) bool {
+	ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+
+	return ready
+}
+
+// GetVolumeSnapshotError returns message of error occurred during snapshot creation, empty if there is no error.
+func GetVolumeSnapshotError(snapshot *unstructured.Unstructured) string {
+	message, _, _ := unstructured.NestedString(snapshot.Object, "status", "error", "message")
+
+	return message
+}
@@ -0,0 +1,44 @@
+package watcher_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"k8s.io/client-go/kubernetes/scheme"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+var (
+	ctx           = context.Background()
+	cancel        context.CancelFunc
+	labelsManager = &k8s.NamespacedLabelsManager{
+		Namespace: "tarantool.io",
+	}
+)
+
+func TestWatcher(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Watcher Suite")
+}
+
+var _ = BeforeSuite(func() {
+	var err error
+
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+	ctx, cancel = context.WithCancel(context.Background())
+
+	err = scheme.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = v1beta1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+}, 60)
+
+var _ = AfterSuite(func() {
+	cancel()
+})
@@ -0,0 +1,162 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tarantool/tarantool-operator/pkg/api"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	"github.com/tarantool/tarantool-operator/pkg/utils"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	DefaultInterval  = 5 * time.Second
+	eventsBufferSize = 64
+)
+
+// TopologyWatcher polls runtime topology state of bootstrapped clusters through their leaders
+// and emits events for Cluster and its Roles when failover changes the state,
+// so master labels, Services and statuses are updated without waiting for kubernetes events.
+type TopologyWatcher struct {
+	ResourcesManager k8s.ResourcesManager
+	Membership       topology.Membership
+	Interval         time.Duration
+
+	// ClusterEvents and RoleEvents are consumed by controllers through source.Channel
+	ClusterEvents chan event.GenericEvent
+	RoleEvents    chan event.GenericEvent
+
+	states map[types.NamespacedName]*topology.TopologyState
+}
+
+func NewTopologyWatcher(
+	resourcesManager k8s.ResourcesManager,
+	membership topology.Membership,
+	interval time.Duration,
+) *TopologyWatcher {
+	return &TopologyWatcher{
+		ResourcesManager: resourcesManager,
+		Membership:       membership,
+		Interval:         interval,
+		ClusterEvents:    make(chan event.GenericEvent, eventsBufferSize),
+		RoleEvents:       make(chan event.GenericEvent, eventsBufferSize),
+		states:           map[types.NamespacedName]*topology.TopologyState{},
+	}
+}
+
+// Start implements manager.Runnable, it polls clusters until ctx is done.
+func (r *TopologyWatcher) Start(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.Poll(ctx)
+		}
+	}
+}
+
+// Poll checks every bootstrapped cluster once.
+// The first observed state of cluster is only remembered, because controllers reconcile all objects on start anyway.
+func (r *TopologyWatcher) Poll(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("topology-watcher")
+
+	clusters, err := r.ResourcesManager.ListClusters(ctx)
+	if err != nil {
+		logger.Error(err, "unable to list clusters")
+
+		return
+	}
+
+	seen := make(map[types.NamespacedName]bool, len(clusters))
+
+	for _, cluster := range clusters {
+		if !cluster.IsBootstrapped() || cluster.GetLeader() == "" {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: cluster.GetNamespace(), Name: cluster.GetName()}
+		seen[key] = true
+
+		state, err := r.getState(ctx, cluster)
+		if err != nil {
+			logger.V(1).Info("unable to retrieve topology state", "cluster", key, "error", err.Error())
+		}
+
+		previous, known := r.states[key]
+		r.states[key] = state
+
+		if !known || cmp.Equal(previous, state) {
+			continue
+		}
+
+		logger.Info("Topology state changed", "cluster", key)
+
+		r.notify(ctx, cluster)
+	}
+
+	for key := range r.states {
+		if !seen[key] {
+			delete(r.states, key)
+		}
+	}
+}
+
+// getState returns nil state when leader is unavailable, the change to and from nil is reported as any other change.
+func (r *TopologyWatcher) getState(ctx context.Context, cluster api.Cluster) (*topology.TopologyState, error) {
+	leader, err := r.ResourcesManager.GetPod(ctx, cluster.GetNamespace(), cluster.GetLeader())
+	if err != nil {
+		return nil, err
+	}
+
+	if utils.IsPodDeleting(leader) || !utils.IsPodRunning(leader) {
+		return nil, nil
+	}
+
+	return r.Membership.GetTopologyState(ctx, leader)
+}
+
+func (r *TopologyWatcher) notify(ctx context.Context, cluster api.Cluster) {
+	logger := log.FromContext(ctx).WithName("topology-watcher")
+
+	roles, err := r.ResourcesManager.GetClusterRoles(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "unable to list roles of cluster", "cluster", cluster.GetName())
+	}
+
+	if !r.send(ctx, r.ClusterEvents, event.GenericEvent{Object: cluster}) {
+		return
+	}
+
+	for _, role := range roles {
+		if !r.send(ctx, r.RoleEvents, event.GenericEvent{Object: role}) {
+			return
+		}
+	}
+}
+
+func (r *TopologyWatcher) send(ctx context.Context, ch chan event.GenericEvent, evt event.GenericEvent) bool {
+	if ch == nil {
+		return true
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case ch <- evt:
+		return true
+	}
+}
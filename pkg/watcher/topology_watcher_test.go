@@ -0,0 +1,133 @@
+package watcher_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	. "github.com/tarantool/tarantool-operator/internal/implementation"
+	"github.com/tarantool/tarantool-operator/pkg/k8s"
+	"github.com/tarantool/tarantool-operator/pkg/topology"
+	. "github.com/tarantool/tarantool-operator/pkg/watcher"
+	"github.com/tarantool/tarantool-operator/test/mocks"
+	"github.com/tarantool/tarantool-operator/test/resources"
+	"github.com/tarantool/tarantool-operator/test/utils"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func newTestWatcher(fakeClient client.Client, fakeMembership *mocks.FakeMembership) *TopologyWatcher {
+	resourcesManager := &ResourcesManager{
+		LabelsManager: labelsManager,
+		CommonResourcesManager: &k8s.CommonResourcesManager{
+			Client: fakeClient,
+			Scheme: scheme.Scheme,
+		},
+	}
+
+	return NewTopologyWatcher(resourcesManager, fakeMembership, DefaultInterval)
+}
+
+func receivedNames(ch chan event.GenericEvent) []string {
+	var names []string
+
+	for {
+		select {
+		case evt := <-ch:
+			names = append(names, evt.Object.GetName())
+		default:
+			return names
+		}
+	}
+}
+
+var _ = Describe("topology watcher unit testing", func() {
+	var (
+		namespace   = "default"
+		clusterName string
+		cartridge   *resources.FakeCartridge
+		state       = &topology.TopologyState{
+			ActiveLeaders: map[string]string{"storage-0": "storage-0-0"},
+			Members:       map[string]string{"storage-0-0:3301": "alive", "storage-0-1:3301": "alive"},
+		}
+		switchedState = &topology.TopologyState{
+			ActiveLeaders: map[string]string{"storage-0": "storage-0-1"},
+			Members:       map[string]string{"storage-0-0:3301": "dead", "storage-0-1:3301": "alive"},
+		}
+	)
+
+	BeforeEach(func() {
+		clusterName = fmt.Sprintf("cluster-%s", utils.RandStringRunes(4))
+		cartridge = resources.NewFakeCartridge(labelsManager).
+			WithNamespace(namespace).
+			WithClusterName(clusterName).
+			WithRouterRole(1, 1).
+			WithStorageRole(1, 2).
+			WithRouterStatefulSetsCreated().
+			WithStorageStatefulSetsCreated().
+			WithRouterPodsCreated().
+			WithStoragePodsCreated().
+			WithAllPodsRunning().
+			WithLeader("router-0-0")
+	})
+
+	It("should not poll clusters which are not bootstrapped", func() {
+		fakeMembership := mocks.NewFakeMembership()
+		watcher := newTestWatcher(cartridge.BuildFakeClient(), fakeMembership)
+
+		watcher.Poll(ctx)
+		watcher.Poll(ctx)
+
+		fakeMembership.AssertNotCalled(GinkgoT(), "GetTopologyState", mock.Anything, mock.Anything)
+		Expect(receivedNames(watcher.ClusterEvents)).To(BeEmpty())
+	})
+
+	It("should enqueue cluster and its roles when active leaders are changed", func() {
+		cartridge.Bootstrapped()
+
+		fakeMembership := mocks.NewFakeMembership()
+		fakeMembership.
+			On("GetTopologyState", mock.Anything, mock.Anything).
+			Return(state, nil).Twice()
+		fakeMembership.
+			On("GetTopologyState", mock.Anything, mock.Anything).
+			Return(switchedState, nil)
+
+		watcher := newTestWatcher(cartridge.BuildFakeClient(), fakeMembership)
+
+		watcher.Poll(ctx)
+		Expect(receivedNames(watcher.ClusterEvents)).To(BeEmpty(), "first observed state should not be reported")
+
+		watcher.Poll(ctx)
+		Expect(receivedNames(watcher.ClusterEvents)).To(BeEmpty(), "unchanged state should not be reported")
+
+		watcher.Poll(ctx)
+		Expect(receivedNames(watcher.ClusterEvents)).To(ConsistOf(clusterName))
+		Expect(receivedNames(watcher.RoleEvents)).To(ConsistOf(resources.RoleRouter, resources.RoleStorage))
+	})
+
+	It("should enqueue cluster and its roles once when leader becomes unavailable", func() {
+		cartridge.Bootstrapped()
+
+		fakeMembership := mocks.NewFakeMembership()
+		fakeMembership.
+			On("GetTopologyState", mock.Anything, mock.Anything).
+			Return(state, nil).Once()
+		fakeMembership.
+			On("GetTopologyState", mock.Anything, mock.Anything).
+			Return((*topology.TopologyState)(nil), errors.New("connection refused"))
+
+		watcher := newTestWatcher(cartridge.BuildFakeClient(), fakeMembership)
+
+		watcher.Poll(ctx)
+		watcher.Poll(ctx)
+		Expect(receivedNames(watcher.ClusterEvents)).To(ConsistOf(clusterName))
+		Expect(receivedNames(watcher.RoleEvents)).To(HaveLen(2))
+
+		watcher.Poll(ctx)
+		Expect(receivedNames(watcher.ClusterEvents)).To(BeEmpty())
+	})
+})
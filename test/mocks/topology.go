@@ -8,11 +8,16 @@ import (
 	"k8s.io/api/core/v1"
 )
 
-type FakeCartridgeTopology struct {
-	mock.Mock
+// FakeExecutor is a fake of topology.Executor.
+type FakeExecutor struct {
+	*mock.Mock
+}
+
+func NewFakeExecutor() *FakeExecutor {
+	return &FakeExecutor{Mock: &mock.Mock{}}
 }
 
-func (f *FakeCartridgeTopology) Exec(ctx context.Context, instance *v1.Pod, res interface{}, lua string, args ...interface{}) error {
+func (f *FakeExecutor) Exec(ctx context.Context, instance *v1.Pod, res interface{}, lua string, args ...interface{}) error {
 	callArgs := []interface{}{ctx, instance, res, lua}
 	callArgs = append(callArgs, args...)
 	called := f.Called(callArgs...)
@@ -20,7 +25,16 @@ func (f *FakeCartridgeTopology) Exec(ctx context.Context, instance *v1.Pod, res
 	return called.Error(0)
 }
 
-func (f *FakeCartridgeTopology) Join(
+// FakeMembership is a fake of topology.Membership.
+type FakeMembership struct {
+	*mock.Mock
+}
+
+func NewFakeMembership() *FakeMembership {
+	return &FakeMembership{Mock: &mock.Mock{}}
+}
+
+func (f *FakeMembership) Join(
 	ctx context.Context,
 	leader *v1.Pod,
 	replicasetAlias string,
@@ -46,74 +60,305 @@ func (f *FakeCartridgeTopology) Join(
 	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) GetInstanceUUID(ctx context.Context, pod *v1.Pod) (string, error) {
+func (f *FakeMembership) ExpelReplicaset(ctx context.Context, leader *v1.Pod, replicasetUUID string) error {
+	args := f.Called(ctx, leader, replicasetUUID)
+
+	return args.Error(0)
+}
+
+func (f *FakeMembership) GetInstanceUUID(ctx context.Context, pod *v1.Pod) (string, error) {
 	args := f.Called(ctx, pod)
 
 	return args.String(0), args.Error(1)
 }
 
-func (f *FakeCartridgeTopology) SetWeight(ctx context.Context, leader *v1.Pod, replicasetUUID string, replicaWeight int32) error {
-	args := f.Called(ctx, leader, replicasetUUID, replicaWeight)
+func (f *FakeMembership) GetReplicasets(ctx context.Context, leader *v1.Pod) ([]topology.ReplicasetInfo, error) {
+	args := f.Called(ctx, leader)
 
-	return args.Error(0)
+	return args.Get(0).([]topology.ReplicasetInfo), args.Error(1)
 }
 
-func (f *FakeCartridgeTopology) GetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string) ([]string, error) {
+func (f *FakeMembership) GetTopologyState(ctx context.Context, leader *v1.Pod) (*topology.TopologyState, error) {
+	args := f.Called(ctx, leader)
+
+	return args.Get(0).(*topology.TopologyState), args.Error(1)
+}
+
+func (f *FakeMembership) IsStarted(ctx context.Context, pod *v1.Pod) (bool, error) {
+	args := f.Called(ctx, pod)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (f *FakeMembership) IsConfigured(ctx context.Context, pod *v1.Pod) (bool, error) {
+	args := f.Called(ctx, pod)
+
+	return args.Bool(0), args.Error(1)
+}
+
+// FakeReplicasetConfig is a fake of topology.ReplicasetConfig.
+type FakeReplicasetConfig struct {
+	*mock.Mock
+}
+
+func NewFakeReplicasetConfig() *FakeReplicasetConfig {
+	return &FakeReplicasetConfig{Mock: &mock.Mock{}}
+}
+
+func (f *FakeReplicasetConfig) GetRolesHierarchy(ctx context.Context, leader *v1.Pod) (map[string][]string, error) {
+	args := f.Called(ctx, leader)
+
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (f *FakeReplicasetConfig) GetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string) ([]string, error) {
 	args := f.Called(ctx, leader, replicasetUUID)
 
 	return args.Get(0).([]string), args.Error(1)
 }
 
-func (f *FakeCartridgeTopology) SetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string, roles []string) error {
+func (f *FakeReplicasetConfig) SetReplicasetRoles(ctx context.Context, leader *v1.Pod, replicasetUUID string, roles []string) error {
 	args := f.Called(ctx, leader, replicasetUUID, roles)
 
 	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) BootstrapVshard(ctx context.Context, leader *v1.Pod) error {
-	args := f.Called(ctx, leader)
+// FakeSharding is a fake of topology.Sharding.
+type FakeSharding struct {
+	*mock.Mock
+}
+
+func NewFakeSharding() *FakeSharding {
+	return &FakeSharding{Mock: &mock.Mock{}}
+}
+
+func (f *FakeSharding) SetWeight(ctx context.Context, leader *v1.Pod, replicasetUUID string, replicaWeight int32) error {
+	args := f.Called(ctx, leader, replicasetUUID, replicaWeight)
 
 	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) GetRolesHierarchy(ctx context.Context, leader *v1.Pod) (map[string][]string, error) {
+func (f *FakeSharding) BootstrapVshard(ctx context.Context, leader *v1.Pod) error {
 	args := f.Called(ctx, leader)
 
-	return args.Get(0).(map[string][]string), args.Error(1)
+	return args.Error(0)
+}
+
+func (f *FakeSharding) GetBucketsCount(ctx context.Context, pod *v1.Pod) (int64, error) {
+	args := f.Called(ctx, pod)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// FakeFailover is a fake of topology.Failover.
+type FakeFailover struct {
+	*mock.Mock
 }
 
-func (f *FakeCartridgeTopology) SetFailoverParams(ctx context.Context, leader *v1.Pod, params *topology.FailoverParams) error {
+func NewFakeFailover() *FakeFailover {
+	return &FakeFailover{Mock: &mock.Mock{}}
+}
+
+func (f *FakeFailover) SetFailoverParams(ctx context.Context, leader *v1.Pod, params *topology.FailoverParams) error {
 	args := f.Called(ctx, leader, params)
 
 	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) GetFailoverParams(ctx context.Context, leader *v1.Pod) (*topology.FailoverParams, error) {
+func (f *FakeFailover) GetFailoverParams(ctx context.Context, leader *v1.Pod) (*topology.FailoverParams, error) {
 	args := f.Called(ctx, leader)
 
 	return args.Get(0).(*topology.FailoverParams), args.Error(1)
 }
 
-func (f *FakeCartridgeTopology) GetCartridgeConfig(ctx context.Context, leader *v1.Pod) (topology.CartridgeConfigData, error) {
+func (f *FakeFailover) SetFailoverPriority(ctx context.Context, leader *v1.Pod, replicasetUUID string, instanceUUIDs []string) error {
+	args := f.Called(ctx, leader, replicasetUUID, instanceUUIDs)
+
+	return args.Error(0)
+}
+
+// FakeClusterwideConfig is a fake of topology.ClusterwideConfig.
+type FakeClusterwideConfig struct {
+	*mock.Mock
+}
+
+func NewFakeClusterwideConfig() *FakeClusterwideConfig {
+	return &FakeClusterwideConfig{Mock: &mock.Mock{}}
+}
+
+func (f *FakeClusterwideConfig) GetConfig(ctx context.Context, leader *v1.Pod) (topology.ClusterwideConfigData, error) {
 	args := f.Called(ctx, leader)
 
-	return args.Get(0).(topology.CartridgeConfigData), args.Error(1)
+	return args.Get(0).(topology.ClusterwideConfigData), args.Error(1)
+}
+
+func (f *FakeClusterwideConfig) ValidateConfig(ctx context.Context, leader *v1.Pod, config topology.ClusterwideConfigData) error {
+	args := f.Called(ctx, leader, config)
+
+	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) ApplyCartridgeConfig(ctx context.Context, leader *v1.Pod, config topology.CartridgeConfigData) error {
+func (f *FakeClusterwideConfig) ApplyConfig(ctx context.Context, leader *v1.Pod, config topology.ClusterwideConfigData) error {
 	args := f.Called(ctx, leader, config)
 
 	return args.Error(0)
 }
 
-func (f *FakeCartridgeTopology) IsCartridgeStarted(ctx context.Context, pod *v1.Pod) (bool, error) {
+func (f *FakeClusterwideConfig) GetSchema(ctx context.Context, leader *v1.Pod) (string, error) {
+	args := f.Called(ctx, leader)
+
+	return args.String(0), args.Error(1)
+}
+
+func (f *FakeClusterwideConfig) CheckSchema(ctx context.Context, leader *v1.Pod, schema string) error {
+	args := f.Called(ctx, leader, schema)
+
+	return args.Error(0)
+}
+
+func (f *FakeClusterwideConfig) ApplySchema(ctx context.Context, leader *v1.Pod, schema string) error {
+	args := f.Called(ctx, leader, schema)
+
+	return args.Error(0)
+}
+
+// FakeBackup is a fake of topology.Backup.
+type FakeBackup struct {
+	*mock.Mock
+}
+
+func NewFakeBackup() *FakeBackup {
+	return &FakeBackup{Mock: &mock.Mock{}}
+}
+
+func (f *FakeBackup) StartBackup(ctx context.Context, pod *v1.Pod) (*topology.BackupFiles, error) {
+	args := f.Called(ctx, pod)
+
+	return args.Get(0).(*topology.BackupFiles), args.Error(1)
+}
+
+func (f *FakeBackup) StopBackup(ctx context.Context, pod *v1.Pod) error {
 	args := f.Called(ctx, pod)
 
+	return args.Error(0)
+}
+
+// FakeStorage is a fake of topology.Storage.
+type FakeStorage struct {
+	*mock.Mock
+}
+
+func NewFakeStorage() *FakeStorage {
+	return &FakeStorage{Mock: &mock.Mock{}}
+}
+
+func (f *FakeStorage) GetStorageUsage(ctx context.Context, pod *v1.Pod) (*topology.StorageUsage, error) {
+	args := f.Called(ctx, pod)
+
+	return args.Get(0).(*topology.StorageUsage), args.Error(1)
+}
+
+// FakeElection is a fake of topology.Election.
+type FakeElection struct {
+	*mock.Mock
+}
+
+func NewFakeElection() *FakeElection {
+	return &FakeElection{Mock: &mock.Mock{}}
+}
+
+func (f *FakeElection) ConfigureElection(ctx context.Context, pod *v1.Pod, params *topology.ElectionParams) (*topology.ElectionState, error) {
+	args := f.Called(ctx, pod, params)
+
+	return args.Get(0).(*topology.ElectionState), args.Error(1)
+}
+
+// FakeReplicationOptions is a fake of topology.ReplicationOptions.
+type FakeReplicationOptions struct {
+	*mock.Mock
+}
+
+func NewFakeReplicationOptions() *FakeReplicationOptions {
+	return &FakeReplicationOptions{Mock: &mock.Mock{}}
+}
+
+func (f *FakeReplicationOptions) SetReplicationParams(ctx context.Context, pod *v1.Pod, params *topology.ReplicationParams) error {
+	args := f.Called(ctx, pod, params)
+
+	return args.Error(0)
+}
+
+// FakeReplication is a fake of topology.Replication.
+type FakeReplication struct {
+	*mock.Mock
+}
+
+func NewFakeReplication() *FakeReplication {
+	return &FakeReplication{Mock: &mock.Mock{}}
+}
+
+func (f *FakeReplication) ConfigureReplication(ctx context.Context, pod *v1.Pod, replication []string, readOnly bool) (bool, error) {
+	args := f.Called(ctx, pod, replication, readOnly)
+
 	return args.Bool(0), args.Error(1)
 }
 
-func (f *FakeCartridgeTopology) IsCartridgeConfigured(ctx context.Context, pod *v1.Pod) (bool, error) {
+func (f *FakeReplication) GetVClock(ctx context.Context, pod *v1.Pod) (topology.VClock, error) {
 	args := f.Called(ctx, pod)
 
+	return args.Get(0).(topology.VClock), args.Error(1)
+}
+
+// FakeDeclarativeConfig is a fake of topology.DeclarativeConfig.
+type FakeDeclarativeConfig struct {
+	*mock.Mock
+}
+
+func NewFakeDeclarativeConfig() *FakeDeclarativeConfig {
+	return &FakeDeclarativeConfig{Mock: &mock.Mock{}}
+}
+
+func (f *FakeDeclarativeConfig) ReloadConfig(ctx context.Context, pod *v1.Pod, checksum string) (bool, error) {
+	args := f.Called(ctx, pod, checksum)
+
 	return args.Bool(0), args.Error(1)
 }
+
+func (f *FakeDeclarativeConfig) BootstrapVShard(ctx context.Context, pod *v1.Pod) error {
+	args := f.Called(ctx, pod)
+
+	return args.Error(0)
+}
+
+// FakeCartridgeTopology is a fake of topology.CartridgeTopology, its capabilities share expectations.
+type FakeCartridgeTopology struct {
+	*mock.Mock
+	*FakeExecutor
+	*FakeMembership
+	*FakeReplicasetConfig
+	*FakeSharding
+	*FakeFailover
+	*FakeClusterwideConfig
+	*FakeBackup
+	*FakeStorage
+	*FakeElection
+	*FakeReplicationOptions
+}
+
+func NewFakeCartridgeTopology() *FakeCartridgeTopology {
+	m := &mock.Mock{}
+
+	return &FakeCartridgeTopology{
+		Mock:                   m,
+		FakeExecutor:           &FakeExecutor{Mock: m},
+		FakeMembership:         &FakeMembership{Mock: m},
+		FakeReplicasetConfig:   &FakeReplicasetConfig{Mock: m},
+		FakeSharding:           &FakeSharding{Mock: m},
+		FakeFailover:           &FakeFailover{Mock: m},
+		FakeClusterwideConfig:  &FakeClusterwideConfig{Mock: m},
+		FakeBackup:             &FakeBackup{Mock: m},
+		FakeStorage:            &FakeStorage{Mock: m},
+		FakeElection:           &FakeElection{Mock: m},
+		FakeReplicationOptions: &FakeReplicationOptions{Mock: m},
+	}
+}
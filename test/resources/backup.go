@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (r *FakeCartridge) WithTarantoolBackup(name string, spec v1beta1.TarantoolBackupSpec) *FakeCartridge {
+	cluster := r.Cluster
+	backup := &v1beta1.TarantoolBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.GetNamespace(),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName(): cluster.GetName(),
+			},
+		},
+		Spec: spec,
+	}
+	r.TarantoolBackups = append(r.TarantoolBackups, backup)
+	r.object(backup)
+
+	return r
+}
+
+func (r *FakeCartridge) WithTarantoolRestore(name string, backup string) *FakeCartridge {
+	cluster := r.Cluster
+	restore := &v1beta1.TarantoolRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.GetNamespace(),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName(): cluster.GetName(),
+			},
+		},
+		Spec: v1beta1.TarantoolRestoreSpec{
+			Backup: backup,
+		},
+	}
+	r.TarantoolRestores = append(r.TarantoolRestores, restore)
+	r.object(restore)
+
+	return r
+}
+
+func (r *FakeCartridge) WithTarantoolBackupPolicy(name string, spec v1beta1.TarantoolBackupPolicySpec, createdAt time.Time) *FakeCartridge {
+	cluster := r.Cluster
+	policy := &v1beta1.TarantoolBackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         cluster.GetNamespace(),
+			CreationTimestamp: metav1.NewTime(createdAt),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName(): cluster.GetName(),
+			},
+		},
+		Spec: spec,
+	}
+	r.TarantoolBackupPolicies = append(r.TarantoolBackupPolicies, policy)
+	r.object(policy)
+
+	return r
+}
+
+// WithPolicyBackup adds backup created by policy in the given phase.
+func (r *FakeCartridge) WithPolicyBackup(policy string, createdAt time.Time, phase v1beta1.TarantoolBackupPhase, location string) *FakeCartridge {
+	cluster := r.Cluster
+	backup := &v1beta1.TarantoolBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fmt.Sprintf("%s-%d", policy, createdAt.Unix()),
+			Namespace:         cluster.GetNamespace(),
+			CreationTimestamp: metav1.NewTime(createdAt),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName():      cluster.GetName(),
+				r.labelsManager.BackupPolicyName(): policy,
+			},
+		},
+		Status: v1beta1.TarantoolBackupStatus{
+			Phase:    phase,
+			Location: location,
+		},
+	}
+	if phase == v1beta1.TarantoolBackupCompleted {
+		backup.SetCompletedAt(createdAt)
+	}
+	r.TarantoolBackups = append(r.TarantoolBackups, backup)
+	r.object(backup)
+
+	return r
+}
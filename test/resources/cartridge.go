@@ -45,6 +45,14 @@ type FakeCartridge struct {
 	StatefulSets map[string]map[string]*appsv1.StatefulSet
 	Pods         []*v1.Pod
 
+	CartridgeConfigs []*v1beta1.CartridgeConfig
+	CartridgeSchemas []*v1beta1.CartridgeSchema
+
+	TarantoolBackups  []*v1beta1.TarantoolBackup
+	TarantoolRestores []*v1beta1.TarantoolRestore
+
+	TarantoolBackupPolicies []*v1beta1.TarantoolBackupPolicy
+
 	objects []client.Object
 }
 
@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (r *FakeCartridge) WithCartridgeConfig(name string, data string, sources ...v1beta1.CartridgeConfigSource) *FakeCartridge {
+	cluster := r.Cluster
+	config := &v1beta1.CartridgeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.GetNamespace(),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName(): cluster.GetName(),
+			},
+		},
+		Spec: v1beta1.CartridgeConfigSpec{
+			Data:    data,
+			Sources: sources,
+		},
+	}
+	r.CartridgeConfigs = append(r.CartridgeConfigs, config)
+	r.object(config)
+
+	return r
+}
+
+func (r *FakeCartridge) WithConfigMap(name string, data map[string]string) *FakeCartridge {
+	r.object(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.Cluster.GetNamespace(),
+		},
+		Data: data,
+	})
+
+	return r
+}
+
+func (r *FakeCartridge) WithSecret(name string, data map[string][]byte) *FakeCartridge {
+	r.object(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.Cluster.GetNamespace(),
+		},
+		Data: data,
+	})
+
+	return r
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"github.com/tarantool/tarantool-operator/apis/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (r *FakeCartridge) WithCartridgeSchema(name string, schema string, allowDestructive bool) *FakeCartridge {
+	cluster := r.Cluster
+	cartridgeSchema := &v1beta1.CartridgeSchema{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.GetNamespace(),
+			Labels: map[string]string{
+				r.labelsManager.ClusterName(): cluster.GetName(),
+			},
+		},
+		Spec: v1beta1.CartridgeSchemaSpec{
+			Schema:           schema,
+			AllowDestructive: allowDestructive,
+		},
+	}
+	r.CartridgeSchemas = append(r.CartridgeSchemas, cartridgeSchema)
+	r.object(cartridgeSchema)
+
+	return r
+}
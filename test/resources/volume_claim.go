@@ -23,3 +23,30 @@ func (r *FakeCartridge) NewDataVolumeClaim() v1.PersistentVolumeClaim {
 		},
 	}
 }
+
+// WithDataVolumes adds data volume claim template to all roles and mounts data volume to all created pods.
+func (r *FakeCartridge) WithDataVolumes() *FakeCartridge {
+	for _, role := range r.Roles {
+		role.Spec.ReplicasetTemplate.VolumeClaimTemplates = []v1.PersistentVolumeClaim{
+			r.NewDataVolumeClaim(),
+		}
+	}
+
+	for _, pod := range r.Pods {
+		pod.Spec.Containers = []v1.Container{
+			r.NewCartridgeContainer(),
+		}
+		pod.Spec.Volumes = []v1.Volume{
+			{
+				Name: "data",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "data-" + pod.GetName(),
+					},
+				},
+			},
+		}
+	}
+
+	return r
+}